@@ -0,0 +1,100 @@
+package adapter
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+const (
+	reasonQuote    = `"`
+	footnoteMarker = "*"
+)
+
+func (riogi *RowIOGatewayImpl) convertAdjustments(rows []string) ([]league.Adjustment, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	adjustments := make([]league.Adjustment, len(rows))
+	for i, row := range rows {
+		adjustment, err := riogi.convertAdjustmentRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert row %d of adjustments: %w", i+1, err)
+		}
+
+		adjustments[i] = adjustment
+	}
+	return adjustments, nil
+}
+
+func (riogi *RowIOGatewayImpl) convertAdjustmentRow(row string) (league.Adjustment, error) {
+	cleaned := strings.TrimSpace(row)
+	if cleaned == "" {
+		return league.Adjustment{}, fmt.Errorf("empty string: %w", ErrMalformedAdjustment)
+	}
+
+	// The reason is optional, but if present is quoted at the end of the row.
+	reason := ""
+	if strings.HasSuffix(cleaned, reasonQuote) {
+		openIdx := strings.Index(cleaned, reasonQuote)
+		if openIdx == len(cleaned)-len(reasonQuote) {
+			return league.Adjustment{}, fmt.Errorf("reason is missing an opening quote: %w", ErrMalformedAdjustment)
+		}
+
+		reason = cleaned[openIdx+len(reasonQuote) : len(cleaned)-len(reasonQuote)]
+		cleaned = strings.TrimSpace(cleaned[:openIdx])
+	}
+
+	// As with game rows, the team name may contain spaces.
+	lastSpaceIdx := strings.LastIndex(cleaned, sideSplitStr)
+	if lastSpaceIdx < 0 {
+		return league.Adjustment{}, fmt.Errorf("expected a space separating team and points but found none: %w",
+			ErrMalformedAdjustment)
+	}
+
+	team := strings.TrimSpace(cleaned[:lastSpaceIdx])
+	pointsStr := strings.TrimSpace(cleaned[lastSpaceIdx+1:])
+
+	points, err := strconv.ParseFloat(pointsStr, 64)
+	if err != nil {
+		return league.Adjustment{}, fmt.Errorf("points is not a number [%s]: %w", pointsStr, ErrMalformedAdjustment)
+	}
+	// ParseFloat accepts NaN and Inf, which can't be added to a total.
+	if math.IsNaN(points) || math.IsInf(points, 0) {
+		return league.Adjustment{}, fmt.Errorf("points is not a finite number [%s]: %w", pointsStr, ErrMalformedAdjustment)
+	}
+
+	return league.Adjustment{
+		Team:   team,
+		Points: points,
+		Reason: reason,
+	}, nil
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputFootnotes(rankings []league.Ranking, loc locale) []string {
+	var rows []string
+	for _, ranking := range rankings {
+		for _, adjustment := range ranking.Adjustments {
+			rows = append(rows, riogi.convertOutputFootnote(adjustment, loc))
+		}
+	}
+	return rows
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputFootnote(adjustment league.Adjustment, loc locale) string {
+	pointSuffix := loc.pointSuffix(adjustment.Points)
+	points := loc.formatPoints(adjustment.Points)
+	if adjustment.Points > 0 {
+		points = "+" + points
+	}
+
+	footnote := fmt.Sprintf("%s %s: %s %s", footnoteMarker, adjustment.Team, points, pointSuffix)
+	if adjustment.Reason != "" {
+		footnote += fmt.Sprintf(" (%s)", adjustment.Reason)
+	}
+	return footnote
+}
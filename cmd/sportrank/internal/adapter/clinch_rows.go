@@ -0,0 +1,51 @@
+package adapter
+
+import (
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+const (
+	clinchedMarker   = "x-"
+	eliminatedMarker = "e-"
+)
+
+// Nil unless there are fixtures to consider.
+func (riogi *RowIOGatewayImpl) calculatePositionRanges(
+	gameResults []league.GameResult,
+	opts Options,
+	leagueOpts league.Options,
+) (map[string]league.PositionRange, error) {
+	if len(opts.FixtureRows) == 0 {
+		return nil, nil
+	}
+
+	fixtures, err := riogi.convertFixtures(opts.FixtureRows)
+	if err != nil {
+		return nil, err
+	}
+	return riogi.usecaseSvc.CalculatePositionRanges(gameResults, fixtures, leagueOpts), nil
+}
+
+// A prefix for the team name, or empty if the team is still in contention.
+func (riogi *RowIOGatewayImpl) determinePositionMarker(
+	ranges map[string]league.PositionRange,
+	team string,
+	opts Options,
+) string {
+	positionRange, ok := ranges[team]
+	if !ok {
+		return ""
+	}
+
+	spots := opts.PlayoffSpots
+	if spots == 0 {
+		spots = 1
+	}
+	if positionRange.Clinched(spots) {
+		return clinchedMarker
+	}
+	if positionRange.Eliminated(spots) {
+		return eliminatedMarker
+	}
+	return ""
+}
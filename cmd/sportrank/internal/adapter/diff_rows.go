@@ -0,0 +1,173 @@
+package adapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+const noChangesRow = "No changes"
+
+func (riogi *RowIOGatewayImpl) DiffResults(rows []string, opts Options, diffOpts DiffOptions) ([]string, error) {
+	outputFormat, err := riogi.convertOutputFormat(opts.OutputFormat, DiffOutputFormatNames())
+	if err != nil {
+		return nil, err
+	}
+	current, err := riogi.convertInput(rows, opts.InputSources, opts)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := riogi.convertInput(diffOpts.PreviousRows, diffOpts.PreviousSources, opts)
+	if err != nil {
+		return nil, fmt.Errorf("previous results: %w", err)
+	}
+	leagueOpts, err := riogi.convertOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	before := riogi.usecaseSvc.CalculateRankings(previous, leagueOpts)
+	after := riogi.usecaseSvc.CalculateRankings(current, leagueOpts)
+	var changes []league.RankingChange
+	for _, change := range riogi.usecaseSvc.DiffRankings(before, after) {
+		if change.Changed() {
+			changes = append(changes, change)
+		}
+	}
+
+	if outputFormat == outputFormatJSON {
+		return riogi.convertOutputChangesJSON(changes)
+	}
+	return riogi.convertOutputChanges(changes, leagueOpts.Metric, defaultLocale), nil
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputChanges(
+	changes []league.RankingChange,
+	metric league.Metric,
+	loc locale,
+) []string {
+	if len(changes) == 0 {
+		return []string{noChangesRow}
+	}
+
+	rows := make([]string, len(changes))
+	for i, change := range changes {
+		switch {
+		case change.New():
+			rows[i] = fmt.Sprintf("%s: new, %d. %s",
+				change.Team, change.After.Rank, riogi.formatRankingValue(*change.After, metric, loc))
+		case change.Removed():
+			rows[i] = fmt.Sprintf("%s: removed, was %d. %s",
+				change.Team, change.Before.Rank, riogi.formatRankingValue(*change.Before, metric, loc))
+		default:
+			rows[i] = fmt.Sprintf("%s: %d. -> %d. (%s), %s -> %s (%s)",
+				change.Team, change.Before.Rank, change.After.Rank, riogi.describeMove(change),
+				riogi.formatRankingNumber(*change.Before, metric, loc), riogi.formatRankingValue(*change.After, metric, loc),
+				riogi.describeValueChange(change, metric, loc))
+		}
+	}
+	return rows
+}
+
+func (riogi *RowIOGatewayImpl) describeMove(change league.RankingChange) string {
+	switch {
+	case change.RankDelta > 0:
+		return fmt.Sprintf("up %d", change.RankDelta)
+	case change.RankDelta < 0:
+		return fmt.Sprintf("down %d", -change.RankDelta)
+	default:
+		return "same"
+	}
+}
+
+func (riogi *RowIOGatewayImpl) describeValueChange(change league.RankingChange, metric league.Metric, loc locale) string {
+	if metric == league.MetricWinPercentage {
+		delta := change.After.WinPercentage() - change.Before.WinPercentage()
+		return riogi.formatSign(delta) + loc.formatNumber(delta, 3)
+	}
+	return fmt.Sprintf("%s%s %s",
+		riogi.formatSign(change.PointsDelta), loc.formatPoints(change.PointsDelta), loc.pointSuffix(change.PointsDelta))
+}
+
+// A plus for zero or more, as the number itself carries any minus.
+func (riogi *RowIOGatewayImpl) formatSign(delta float64) string {
+	if delta >= 0 {
+		return "+"
+	}
+	return ""
+}
+
+// The value teams are ranked by, with any unit.
+func (riogi *RowIOGatewayImpl) formatRankingValue(ranking league.Ranking, metric league.Metric, loc locale) string {
+	if metric == league.MetricWinPercentage {
+		return riogi.formatRankingNumber(ranking, metric, loc)
+	}
+	return fmt.Sprintf("%s %s", riogi.formatRankingNumber(ranking, metric, loc), loc.pointSuffix(ranking.Points))
+}
+
+// The value teams are ranked by, without any unit.
+func (riogi *RowIOGatewayImpl) formatRankingNumber(ranking league.Ranking, metric league.Metric, loc locale) string {
+	if metric == league.MetricWinPercentage {
+		return loc.formatNumber(ranking.WinPercentage(), 3)
+	}
+	return loc.formatPoints(ranking.Points)
+}
+
+type rankingChangeJSON struct {
+	Team        string       `json:"team"`
+	Status      string       `json:"status"`
+	Before      *rankingJSON `json:"before,omitempty"`
+	After       *rankingJSON `json:"after,omitempty"`
+	RankDelta   int          `json:"rankDelta"`
+	PointsDelta float64      `json:"pointsDelta"`
+}
+
+type rankingJSON struct {
+	Rank          uint    `json:"rank"`
+	Points        float64 `json:"points"`
+	WinPercentage float64 `json:"winPercentage"`
+}
+
+const (
+	changeStatusNew     = "new"
+	changeStatusRemoved = "removed"
+	changeStatusChanged = "changed"
+)
+
+func (riogi *RowIOGatewayImpl) convertOutputChangesJSON(changes []league.RankingChange) ([]string, error) {
+	converted := make([]rankingChangeJSON, len(changes))
+	for i, change := range changes {
+		converted[i] = rankingChangeJSON{
+			Team:        change.Team,
+			Status:      changeStatusChanged,
+			Before:      riogi.convertRankingJSON(change.Before),
+			After:       riogi.convertRankingJSON(change.After),
+			RankDelta:   change.RankDelta,
+			PointsDelta: change.PointsDelta,
+		}
+		if change.New() {
+			converted[i].Status = changeStatusNew
+		} else if change.Removed() {
+			converted[i].Status = changeStatusRemoved
+		}
+	}
+
+	encoded, err := json.MarshalIndent(converted, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("could not encode changes as JSON: %w", err)
+	}
+	return strings.Split(string(encoded), "\n"), nil
+}
+
+func (riogi *RowIOGatewayImpl) convertRankingJSON(ranking *league.Ranking) *rankingJSON {
+	if ranking == nil {
+		return nil
+	}
+	return &rankingJSON{
+		Rank:          ranking.Rank,
+		Points:        ranking.Points,
+		WinPercentage: ranking.WinPercentage(),
+	}
+}
@@ -0,0 +1,268 @@
+package adapter
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Locales which may be given in Options.
+const (
+	localeEnglish   = "en"
+	localeAfrikaans = "af"
+	localeGerman    = "de"
+	localeFrench    = "fr"
+)
+
+// The names of locales which may be given in Options.
+func LocaleNames() []string {
+	return []string{localeEnglish, localeAfrikaans, localeGerman, localeFrench}
+}
+
+// locale is a message catalog for ranking output, along with the language's
+// plural rule, ordinals and number formatting.
+type locale struct {
+	// The language tag, e.g. for the HTML lang attribute.
+	tag      string
+	messages localeMessages
+	// Whether a count takes the singular form.
+	singular func(count float64) bool
+	ordinal  func(n int64) string
+	// Between the whole and fractional parts of a number.
+	decimalSeparator string
+}
+
+type localeMessages struct {
+	pointSingular string
+	pointPlural   string
+	// Table headers.
+	rankHeader               string
+	teamHeader               string
+	pointsHeader             string
+	winPercentageHeader      string
+	ratingHeader             string
+	deviationHeader          string
+	volatilityHeader         string
+	strengthHeader           string
+	vsAverageHeader          string
+	scheduleHeader           string
+	opponentsOpponentsHeader string
+	// Labels within text rows.
+	deviation          string
+	volatility         string
+	vsAverage          string
+	schedule           string
+	opponentsOpponents string
+	// The title of standalone pages, e.g. HTML output.
+	title string
+}
+
+var locales = map[string]locale{
+	localeEnglish: {
+		tag: localeEnglish,
+		messages: localeMessages{
+			pointSingular:            "pt",
+			pointPlural:              "pts",
+			rankHeader:               "Rank",
+			teamHeader:               "Team",
+			pointsHeader:             "Pts",
+			winPercentageHeader:      "Win %",
+			ratingHeader:             "Rating",
+			deviationHeader:          "RD",
+			volatilityHeader:         "Volatility",
+			strengthHeader:           "Strength",
+			vsAverageHeader:          "Vs average",
+			scheduleHeader:           "SoS",
+			opponentsOpponentsHeader: "Opp. opp.",
+			deviation:                "RD",
+			volatility:               "vol",
+			vsAverage:                "vs average",
+			schedule:                 "SoS",
+			opponentsOpponents:       "opp. opp.",
+			title:                    "Standings",
+		},
+		singular:         singularForOne,
+		ordinal:          englishOrdinal,
+		decimalSeparator: ".",
+	},
+	localeAfrikaans: {
+		tag: localeAfrikaans,
+		messages: localeMessages{
+			pointSingular:            "punt",
+			pointPlural:              "punte",
+			rankHeader:               "Plek",
+			teamHeader:               "Span",
+			pointsHeader:             "Punte",
+			winPercentageHeader:      "Wen %",
+			ratingHeader:             "Gradering",
+			deviationHeader:          "RD",
+			volatilityHeader:         "Wisselvalligheid",
+			strengthHeader:           "Sterkte",
+			vsAverageHeader:          "Teen gemiddeld",
+			scheduleHeader:           "SvS",
+			opponentsOpponentsHeader: "Teenst. teenst.",
+			deviation:                "RD",
+			volatility:               "vol",
+			vsAverage:                "teen gemiddeld",
+			schedule:                 "SvS",
+			opponentsOpponents:       "teenst. teenst.",
+			title:                    "Puntelys",
+		},
+		singular:         singularForOne,
+		ordinal:          afrikaansOrdinal,
+		decimalSeparator: ",",
+	},
+	localeGerman: {
+		tag: localeGerman,
+		messages: localeMessages{
+			pointSingular:            "Pkt.",
+			pointPlural:              "Pkt.",
+			rankHeader:               "Platz",
+			teamHeader:               "Mannschaft",
+			pointsHeader:             "Pkt.",
+			winPercentageHeader:      "Sieg-%",
+			ratingHeader:             "Wertung",
+			deviationHeader:          "RD",
+			volatilityHeader:         "Volatilität",
+			strengthHeader:           "Stärke",
+			vsAverageHeader:          "Gegen Durchschnitt",
+			scheduleHeader:           "SdS",
+			opponentsOpponentsHeader: "Geg. Geg.",
+			deviation:                "RD",
+			volatility:               "Vol.",
+			vsAverage:                "gegen Durchschnitt",
+			schedule:                 "SdS",
+			opponentsOpponents:       "Geg. Geg.",
+			title:                    "Tabelle",
+		},
+		singular:         singularForOne,
+		ordinal:          germanOrdinal,
+		decimalSeparator: ",",
+	},
+	localeFrench: {
+		tag: localeFrench,
+		messages: localeMessages{
+			pointSingular:            "pt",
+			pointPlural:              "pts",
+			rankHeader:               "Rang",
+			teamHeader:               "Équipe",
+			pointsHeader:             "Pts",
+			winPercentageHeader:      "% victoires",
+			ratingHeader:             "Cote",
+			deviationHeader:          "RD",
+			volatilityHeader:         "Volatilité",
+			strengthHeader:           "Force",
+			vsAverageHeader:          "Contre la moyenne",
+			scheduleHeader:           "FdC",
+			opponentsOpponentsHeader: "Adv. adv.",
+			deviation:                "RD",
+			volatility:               "vol",
+			vsAverage:                "contre la moyenne",
+			schedule:                 "FdC",
+			opponentsOpponents:       "adv. adv.",
+			title:                    "Classement",
+		},
+		singular:         singularBelowTwo,
+		ordinal:          frenchOrdinal,
+		decimalSeparator: ",",
+	},
+}
+
+// The output locale when none is given.
+var defaultLocale = locales[localeEnglish]
+
+// Accepts a language with a region, e.g. af-ZA or fr_FR, by its language.
+func (riogi *RowIOGatewayImpl) convertLocale(name string) (locale, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(name))
+	if cleaned == "" {
+		return defaultLocale, nil
+	}
+	if i := strings.IndexAny(cleaned, "-_"); i >= 0 {
+		cleaned = cleaned[:i]
+	}
+
+	loc, ok := locales[cleaned]
+	if !ok {
+		return locale{}, fmt.Errorf("%s - %w, expected one of: %s",
+			name, ErrUnknownLocale, strings.Join(LocaleNames(), ", "))
+	}
+	return loc, nil
+}
+
+// A number to a fixed number of decimals, or as few as needed if decimals is
+// negative.
+func (loc locale) formatNumber(number float64, decimals int) string {
+	return strings.Replace(strconv.FormatFloat(number, 'f', decimals, 64), ".", loc.decimalSeparator, 1)
+}
+
+// Points are usually whole, but may be fractional, e.g. 1.5 in chess.
+func (loc locale) formatPoints(points float64) string {
+	return loc.formatNumber(points, -1)
+}
+
+func (loc locale) pointSuffix(points float64) string {
+	return loc.pluralize(points, loc.messages.pointSingular, loc.messages.pointPlural)
+}
+
+func (loc locale) pluralize(count float64, singular string, plural string) string {
+	if loc.singular(count) {
+		return singular
+	}
+	return plural
+}
+
+// --- Plural rules ---
+
+// The singular form for a count of one (or minus one), otherwise the plural.
+func singularForOne(count float64) bool {
+	return count == 1 || count == -1
+}
+
+// The singular form for counts from zero up to, but excluding, two.
+func singularBelowTwo(count float64) bool {
+	return math.Abs(count) < 2
+}
+
+// --- Ordinals ---
+
+// 1st, 2nd, 3rd, 4th... 11th, 12th, 13th... 21st...
+func englishOrdinal(n int64) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// 1ste, 2de... 7de, 8ste, 9de... 19de, 20ste, 21ste... 100ste, 101ste, 102de...
+func afrikaansOrdinal(n int64) string {
+	suffix := "de"
+	switch rem := n % 100; {
+	case rem == 1, rem == 8, rem >= 20, rem == 0 && n > 0:
+		suffix = "ste"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// 1., 2., 3....
+func germanOrdinal(n int64) string {
+	return fmt.Sprintf("%d.", n)
+}
+
+// 1er, 2e, 3e...
+func frenchOrdinal(n int64) string {
+	if n == 1 {
+		return "1er"
+	}
+	return fmt.Sprintf("%de", n)
+}
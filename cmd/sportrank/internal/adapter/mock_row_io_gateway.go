@@ -9,13 +9,13 @@ type MockRowIOGateway struct {
 	mock.Mock
 }
 
-// CalculateRankings provides a mock function with given fields: rows
-func (_m *MockRowIOGateway) CalculateRankings(rows []string) ([]string, error) {
-	ret := _m.Called(rows)
+// CalculateRankings provides a mock function with given fields: rows, opts
+func (_m *MockRowIOGateway) CalculateRankings(rows []string, opts Options) ([]string, error) {
+	ret := _m.Called(rows, opts)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func([]string) []string); ok {
-		r0 = rf(rows)
+	if rf, ok := ret.Get(0).(func([]string, Options) []string); ok {
+		r0 = rf(rows, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -23,8 +23,8 @@ func (_m *MockRowIOGateway) CalculateRankings(rows []string) ([]string, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]string) error); ok {
-		r1 = rf(rows)
+	if rf, ok := ret.Get(1).(func([]string, Options) error); ok {
+		r1 = rf(rows, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -32,6 +32,156 @@ func (_m *MockRowIOGateway) CalculateRankings(rows []string) ([]string, error) {
 	return r0, r1
 }
 
+// DiffResults provides a mock function with given fields: rows, opts, diffOpts
+func (_m *MockRowIOGateway) DiffResults(rows []string, opts Options, diffOpts DiffOptions) ([]string, error) {
+	ret := _m.Called(rows, opts, diffOpts)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func([]string, Options, DiffOptions) []string); ok {
+		r0 = rf(rows, opts, diffOpts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, Options, DiffOptions) error); ok {
+		r1 = rf(rows, opts, diffOpts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvaluateWhatIf provides a mock function with given fields: rows, opts, whatIfOpts
+func (_m *MockRowIOGateway) EvaluateWhatIf(rows []string, opts Options, whatIfOpts WhatIfOptions) ([]string, error) {
+	ret := _m.Called(rows, opts, whatIfOpts)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func([]string, Options, WhatIfOptions) []string); ok {
+		r0 = rf(rows, opts, whatIfOpts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, Options, WhatIfOptions) error); ok {
+		r1 = rf(rows, opts, whatIfOpts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTeamGames provides a mock function with given fields: rows, opts, team
+func (_m *MockRowIOGateway) ListTeamGames(rows []string, opts Options, team string) ([]string, error) {
+	ret := _m.Called(rows, opts, team)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func([]string, Options, string) []string); ok {
+		r0 = rf(rows, opts, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, Options, string) error); ok {
+		r1 = rf(rows, opts, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PredictFixtures provides a mock function with given fields: rows, opts, predictOpts
+func (_m *MockRowIOGateway) PredictFixtures(rows []string, opts Options, predictOpts PredictionOptions) ([]string, error) {
+	ret := _m.Called(rows, opts, predictOpts)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func([]string, Options, PredictionOptions) []string); ok {
+		r0 = rf(rows, opts, predictOpts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, Options, PredictionOptions) error); ok {
+		r1 = rf(rows, opts, predictOpts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimulateSeasons provides a mock function with given fields: rows, opts, simOpts
+func (_m *MockRowIOGateway) SimulateSeasons(rows []string, opts Options, simOpts SimulationOptions) ([]string, error) {
+	ret := _m.Called(rows, opts, simOpts)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func([]string, Options, SimulationOptions) []string); ok {
+		r0 = rf(rows, opts, simOpts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, Options, SimulationOptions) error); ok {
+		r1 = rf(rows, opts, simOpts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TabulateRankings provides a mock function with given fields: rows, opts
+func (_m *MockRowIOGateway) TabulateRankings(rows []string, opts Options) (Table, error) {
+	ret := _m.Called(rows, opts)
+
+	var r0 Table
+	if rf, ok := ret.Get(0).(func([]string, Options) Table); ok {
+		r0 = rf(rows, opts)
+	} else {
+		r0 = ret.Get(0).(Table)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, Options) error); ok {
+		r1 = rf(rows, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateInput provides a mock function with given fields: rows, opts
+func (_m *MockRowIOGateway) ValidateInput(rows []string, opts Options) error {
+	ret := _m.Called(rows, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, Options) error); ok {
+		r0 = rf(rows, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockRowIOGateway interface {
 	mock.TestingT
 	Cleanup(func())
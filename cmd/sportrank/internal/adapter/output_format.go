@@ -0,0 +1,38 @@
+package adapter
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	outputFormatText     = "text"
+	outputFormatJSON     = "json"
+	outputFormatMarkdown = "markdown"
+	outputFormatHTML     = "html"
+)
+
+// The names of output formats which may be given in Options, when ranking.
+func RankingOutputFormatNames() []string {
+	return []string{outputFormatText, outputFormatMarkdown, outputFormatHTML}
+}
+
+// The names of output formats which may be given in Options, when diffing.
+func DiffOutputFormatNames() []string {
+	return []string{outputFormatText, outputFormatJSON}
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputFormat(outputFormat string, names []string) (string, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(outputFormat))
+	if cleaned == "" {
+		return outputFormatText, nil
+	}
+
+	for _, name := range names {
+		if cleaned == name {
+			return cleaned, nil
+		}
+	}
+	return "", fmt.Errorf("%s - %w, expected one of: %s",
+		outputFormat, ErrUnknownOutputFormat, strings.Join(names, ", "))
+}
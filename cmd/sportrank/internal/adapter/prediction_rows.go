@@ -0,0 +1,69 @@
+package adapter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+// The number of most likely scorelines listed for each fixture.
+const predictedScorelines = 5
+
+func (riogi *RowIOGatewayImpl) PredictFixtures(rows []string, opts Options, predictOpts PredictionOptions) ([]string, error) {
+	gameResults, err := riogi.convertInput(rows, opts.InputSources, opts)
+	if err != nil {
+		return nil, err
+	}
+	fixtures, err := riogi.convertFixtures(predictOpts.FixtureRows)
+	if err != nil {
+		return nil, err
+	}
+
+	poissonOpts := league.DefaultPoissonOptions()
+	poissonOpts.HomeAdvantage = predictOpts.HomeAdvantage
+	model := riogi.usecaseSvc.FitPoissonModel(gameResults, poissonOpts)
+
+	var outputRows []string
+	for i, fixture := range fixtures {
+		// A prediction for a team the model knows nothing about would be
+		// meaningless, and is most likely a typo.
+		for _, team := range []string{fixture.TeamA, fixture.TeamB} {
+			if !model.Knows(team) {
+				return nil, fmt.Errorf("could not predict fixture %d: %s - %w", i, team, ErrUnknownTeam)
+			}
+		}
+
+		if i > 0 {
+			outputRows = append(outputRows, "")
+		}
+		prediction := model.Predict(fixture.TeamA, fixture.TeamB, predictOpts.HomeAdvantage)
+		outputRows = append(outputRows, riogi.convertOutputPrediction(fixture, prediction)...)
+	}
+	return outputRows, nil
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputPrediction(fixture league.Fixture, prediction league.Prediction) []string {
+	scorelines := make([]string, 0, predictedScorelines)
+	for _, scoreline := range prediction.Scorelines {
+		if len(scorelines) == predictedScorelines {
+			break
+		}
+		scorelines = append(scorelines, fmt.Sprintf("%d-%d (%s)",
+			scoreline.ScoreA, scoreline.ScoreB, formatProbability(scoreline.Probability)))
+	}
+
+	return []string{
+		fmt.Sprintf("%s vs %s", fixture.TeamA, fixture.TeamB),
+		fmt.Sprintf("Expected score: %.2f - %.2f", prediction.ExpectedA, prediction.ExpectedB),
+		fmt.Sprintf("%s win %s, draw %s, %s win %s",
+			fixture.TeamA, formatProbability(prediction.Win),
+			formatProbability(prediction.Draw),
+			fixture.TeamB, formatProbability(prediction.Loss)),
+		fmt.Sprintf("Most likely scores: %s", strings.Join(scorelines, rowSplitStr+sideSplitStr)),
+	}
+}
+
+func formatProbability(probability float64) string {
+	return fmt.Sprintf("%.1f%%", probability*100)
+}
@@ -0,0 +1,152 @@
+package adapter
+
+import (
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+// Ranking methods which may be given in Options.
+const (
+	rankByPoints  = "points"
+	rankByGlicko2 = "glicko2"
+	// Bradley-Terry, with draws via the Davidson extension.
+	rankByBradleyTerry = "bradley-terry"
+	// Matrix methods, by wins and losses or by score margins respectively.
+	rankByColley = "colley"
+	rankByMassey = "massey"
+)
+
+// The names of ranking methods which may be given in Options.
+func RankByNames() []string {
+	return []string{rankByPoints, rankByGlicko2, rankByBradleyTerry, rankByColley, rankByMassey}
+}
+
+func (riogi *RowIOGatewayImpl) calculateGlicko2Rankings(
+	gameResults []league.GameResult,
+	style league.RankingStyle,
+	opts Options,
+	loc locale,
+) rankingTable {
+	glicko2Opts := league.DefaultGlicko2Options()
+	glicko2Opts.PeriodLength = opts.RatingPeriod
+	glicko2Opts.RankingStyle = style
+
+	ratings := riogi.usecaseSvc.CalculateGlicko2Ratings(gameResults, glicko2Opts)
+
+	teamValues := make(map[string]float64, len(ratings))
+	for _, rating := range ratings {
+		teamValues[rating.Team] = rating.Rating
+	}
+	schedules := riogi.calculateStrengthOfSchedule(gameResults, opts, teamValues)
+
+	table := rankingTable{
+		columns: append([]tableColumn{
+			{header: loc.messages.rankHeader, numeric: true},
+			{header: loc.messages.teamHeader},
+			{header: loc.messages.ratingHeader, numeric: true},
+			{header: loc.messages.deviationHeader, numeric: true},
+			{header: loc.messages.volatilityHeader, numeric: true},
+		}, riogi.strengthOfScheduleColumns(schedules, loc)...),
+	}
+	shared := riogi.determineSharedRanks(len(ratings), func(i int) uint { return ratings[i].Rank })
+	for _, rating := range ratings {
+		cells := append([]string{
+			riogi.formatRank(rating.Rank, shared, opts),
+			rating.Team,
+			loc.formatNumber(rating.Rating, 0),
+			loc.formatNumber(rating.Deviation, 0),
+			loc.formatNumber(rating.Volatility, 4),
+		}, riogi.strengthOfScheduleCells(schedules, rating.Team, 0, loc)...)
+		row := riogi.convertOutputGlicko2Rating(riogi.formatRank(rating.Rank, shared, opts), rating, loc)
+		table.addRow(riogi.addStrengthOfSchedule(row, schedules, rating.Team, 0, loc), cells...)
+	}
+	return table
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputGlicko2Rating(rank string, rating league.Glicko2Rating, loc locale) textRow {
+	return textRow{rank: rank, team: rating.Team, values: []string{
+		loc.formatNumber(rating.Rating, 0),
+		"(" + loc.messages.deviation, loc.formatNumber(rating.Deviation, 0) + ",",
+		loc.messages.volatility, loc.formatNumber(rating.Volatility, 4) + ")",
+	}}
+}
+
+func (riogi *RowIOGatewayImpl) calculateBradleyTerryRankings(
+	gameResults []league.GameResult,
+	style league.RankingStyle,
+	opts Options,
+	loc locale,
+) rankingTable {
+	bradleyTerryOpts := league.DefaultBradleyTerryOptions()
+	bradleyTerryOpts.RankingStyle = style
+	model := riogi.usecaseSvc.CalculateBradleyTerryRatings(gameResults, bradleyTerryOpts)
+
+	teamValues := make(map[string]float64, len(model.Ratings))
+	for _, rating := range model.Ratings {
+		teamValues[rating.Team] = rating.Strength
+	}
+	schedules := riogi.calculateStrengthOfSchedule(gameResults, opts, teamValues)
+
+	table := rankingTable{
+		columns: append([]tableColumn{
+			{header: loc.messages.rankHeader, numeric: true},
+			{header: loc.messages.teamHeader},
+			{header: loc.messages.strengthHeader, numeric: true},
+			{header: loc.messages.vsAverageHeader, numeric: true},
+		}, riogi.strengthOfScheduleColumns(schedules, loc)...),
+	}
+	shared := riogi.determineSharedRanks(len(model.Ratings), func(i int) uint { return model.Ratings[i].Rank })
+	for _, rating := range model.Ratings {
+		cells := append([]string{
+			riogi.formatRank(rating.Rank, shared, opts),
+			rating.Team,
+			loc.formatNumber(rating.Strength, 3),
+			loc.formatNumber(rating.WinProbability*100, 1) + "%",
+		}, riogi.strengthOfScheduleCells(schedules, rating.Team, 3, loc)...)
+		row := riogi.convertOutputBradleyTerryRating(riogi.formatRank(rating.Rank, shared, opts), rating, loc)
+		table.addRow(riogi.addStrengthOfSchedule(row, schedules, rating.Team, 3, loc), cells...)
+	}
+	return table
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputBradleyTerryRating(
+	rank string,
+	rating league.BradleyTerryRating,
+	loc locale,
+) textRow {
+	return textRow{rank: rank, team: rating.Team, values: []string{
+		loc.formatNumber(rating.Strength, 3),
+		"(" + loc.formatNumber(rating.WinProbability*100, 1) + "%", loc.messages.vsAverage + ")",
+	}}
+}
+
+func (riogi *RowIOGatewayImpl) calculateMatrixRankings(
+	gameResults []league.GameResult,
+	ratings []league.MatrixRating,
+	decimals int,
+	opts Options,
+	loc locale,
+) rankingTable {
+	teamValues := make(map[string]float64, len(ratings))
+	for _, rating := range ratings {
+		teamValues[rating.Team] = rating.Rating
+	}
+	schedules := riogi.calculateStrengthOfSchedule(gameResults, opts, teamValues)
+
+	table := rankingTable{
+		columns: append([]tableColumn{
+			{header: loc.messages.rankHeader, numeric: true},
+			{header: loc.messages.teamHeader},
+			{header: loc.messages.ratingHeader, numeric: true},
+		}, riogi.strengthOfScheduleColumns(schedules, loc)...),
+	}
+	shared := riogi.determineSharedRanks(len(ratings), func(i int) uint { return ratings[i].Rank })
+	for _, rating := range ratings {
+		rank := riogi.formatRank(rating.Rank, shared, opts)
+		value := loc.formatNumber(rating.Rating, decimals)
+		cells := append([]string{rank, rating.Team, value},
+			riogi.strengthOfScheduleCells(schedules, rating.Team, decimals, loc)...)
+		row := textRow{rank: rank, team: rating.Team, values: []string{value}}
+		table.addRow(riogi.addStrengthOfSchedule(row, schedules, rating.Team, decimals, loc), cells...)
+	}
+	return table
+}
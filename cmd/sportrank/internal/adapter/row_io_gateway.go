@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/usecase"
 	"github.com/liampulles/ranking-cli/pkg/league"
@@ -12,7 +13,18 @@ import (
 
 // Defined errors
 var (
-	ErrMalformedRow = errors.New("input row is malformed, it should be of the form <TeamA> <ScoreA>, <TeamB> <ScoreB>")
+	ErrMalformedRow        = errors.New("input row is malformed, it should be of the form <TeamA> <ScoreA>, <TeamB> <ScoreB>")
+	ErrMalformedAdjustment = errors.New("adjustment row is malformed, it should be of the form <Team> <Points> \"<Reason>\"")
+	ErrMalformedRules      = errors.New("rules are malformed, they should be YAML with win, draw, loss, overtime, shootout and bonuses fields")
+	ErrUnknownSport        = errors.New("unknown sport")
+	ErrUnknownRankBy       = errors.New("unknown ranking method")
+	ErrMalformedFixture    = errors.New("fixture row is malformed, it should be of the form <TeamA>, <TeamB>")
+	ErrUnknownTeam         = errors.New("team has not played any games")
+	ErrUnknownOutputFormat = errors.New("unknown output format")
+	ErrMalformedTemplate   = errors.New("output template is malformed, it should be a Go text/template")
+	ErrUnknownLocale       = errors.New("unknown locale")
+	ErrUnknownRankingStyle = errors.New("unknown ranking style")
+	ErrFixturesNeedPoints  = errors.New("fixtures can only be used when ranking by points")
 )
 
 // RowIOGateway facilitates access to usecases of the system via "row"
@@ -20,9 +32,182 @@ var (
 type RowIOGateway interface {
 	// Each input row should be of the form (ignoring quotes):
 	// "<TeamA> <ScoreA>, <TeamB> <ScoreB>"
+	// A game awarded without a scoreline marks the awarded team with W/O, e.g.
+	// "<TeamA> W/O, <TeamB>", and an abandoned game is annotated with
+	// "(abandoned)" at the end of the row, with or without scores. A game decided
+	// in overtime or by a shootout is annotated with "(OT)" or "(SO)".
 	// The resulting output rankings will be of the form (ignoring quotes):
 	// "<Rank>. <Team>, <Points> <pt/pts>"
-	CalculateRankings(rows []string) ([]string, error)
+	// where a shared rank is prefixed with the tie marker in opts, if any,
+	// and, given fixture rows in opts, the team is prefixed with "x-" if it
+	// has clinched a playoff spot, or "e-" if it has been eliminated from one,
+	// or, for sports ranked by win percentage:
+	// "<Rank>. <Team>, <Win percentage>"
+	// or, when ranking by Glicko-2 rating:
+	// "<Rank>. <Team>, <Rating> (RD <Deviation>, vol <Volatility>)"
+	// Adjustment rows in opts should be of the form (the reason is optional):
+	// <Team> <Points> "<Reason>"
+	// Rules rows in opts are the lines of a YAML rules file, and template rows
+	// in opts are the lines of a Go text/template to render the rankings with.
+	// The point suffix, labels and numbers follow the locale in opts.
+	CalculateRankings(rows []string, opts Options) ([]string, error)
+	// Input rows and opts are as for CalculateRankings, but the rankings are
+	// given as a table, e.g. for the TUI, with a cell per column for each team.
+	// Footnotes, templates and the output format are left out.
+	TabulateRankings(rows []string, opts Options) (Table, error)
+	// Input rows are the games played so far, as for CalculateRankings, and
+	// fixture rows in simOpts are the games remaining, of the form:
+	// "<TeamA>, <TeamB>"
+	// Points are assigned as for CalculateRankings. The output is a header row
+	// of positions, followed by a row per team of the form:
+	// "<Team>, <Probability of 1st>, <Probability of 2nd>, ..."
+	SimulateSeasons(rows []string, opts Options, simOpts SimulationOptions) ([]string, error)
+	// Input rows are the games played so far, as for CalculateRankings, and
+	// fixture rows in predictOpts are the games to predict, as for
+	// SimulateSeasons.
+	// The output is a block of rows per fixture, separated by a blank row:
+	// "<TeamA> vs <TeamB>"
+	// "Expected score: <ScoreA> - <ScoreB>"
+	// "<TeamA> win <Probability>, draw <Probability>, <TeamB> win <Probability>"
+	// "Most likely scores: <ScoreA>-<ScoreB> (<Probability>), ..."
+	PredictFixtures(rows []string, opts Options, predictOpts PredictionOptions) ([]string, error)
+	// Input rows are the games played so far, as for CalculateRankings, and
+	// hypothetical rows in whatIfOpts are games which might be played, in the same
+	// form. The output is the table before and after the hypothetical games,
+	// side by side, of the form:
+	// "<Rank>. <Team>, <Points> <pt/pts> | <Rank>. <Team>, <Points> <pt/pts> (<Change>)"
+	// where the change is in position and points, or "new" for teams which
+	// only appear in the hypothetical games.
+	EvaluateWhatIf(rows []string, opts Options, whatIfOpts WhatIfOptions) ([]string, error)
+	// Input rows are the current results, as for CalculateRankings, and
+	// previous rows in diffOpts are the results before, e.g., a correction. The
+	// output is a row per team whose ranking changed, of the form:
+	// "<Team>: <Rank>. -> <Rank>. (<Move>), <Points> -> <Points> pts (<Change>)"
+	// "<Team>: new, <Rank>. <Points> pts"
+	// "<Team>: removed, was <Rank>. <Points> pts"
+	// or, for the JSON output format, a JSON array of changes.
+	DiffResults(rows []string, opts Options, diffOpts DiffOptions) ([]string, error)
+	// Input rows are the results, as for CalculateRankings. The output is a
+	// row per game the team was in, in the order given, of the form:
+	// "<W/D/L> <Score>-<Opponent score> vs <Opponent>"
+	// where the scores are W/O for a game awarded without a scoreline, and
+	// abandoned games are of the form "- abandoned vs <Opponent>".
+	ListTeamGames(rows []string, opts Options, team string) ([]string, error)
+	// Input rows are results, as for CalculateRankings. Returns an error if
+	// any row is malformed, e.g. when results are entered one at a time.
+	ValidateInput(rows []string, opts Options) error
+}
+
+// Options customise how rows are converted, and how rankings are output.
+// Options particular to other methods are given separately, e.g.
+// SimulationOptions.
+type Options struct {
+	// Scores below zero are rejected unless this is set.
+	AllowNegativeScores bool
+	// The largest plausible score. Zero means there is no upper bound.
+	MaxScore int
+	// A built-in scoring system, see SportNames. Empty means the league defaults.
+	Sport string
+	// Points for the team awarded a game without a scoreline, e.g. by walkover.
+	// Nil means the same as a win for the sport.
+	ForfeitWinPoints *float64
+	// Points for the team which forfeited a game. Nil means the same as a loss
+	// for the sport.
+	ForfeitLosePoints *float64
+	// Point adjustments applied after game results, e.g. deductions.
+	AdjustmentRows []string
+	// Mark adjusted teams with an asterisk, and list the adjustments in footnotes.
+	AnnotateAdjustments bool
+	// Lines of a YAML rules file, which customise points for wins, draws,
+	// losses and bonuses.
+	RulesRows []string
+	// How teams are ranked, see RankByNames. Empty means by points.
+	RankBy string
+	// The length of a Glicko-2 rating period, grouping games by date. Zero
+	// groups games by round instead.
+	RatingPeriod time.Duration
+	// Add each team's strength of schedule to its row, by the same measure
+	// teams are ranked by. For points, this is points per game.
+	StrengthOfSchedule bool
+	// Games remaining in the season. When ranking by points, teams which have
+	// clinched a playoff spot or been eliminated from one are marked.
+	FixtureRows []string
+	// The number of playoff spots, for marking teams. Zero means just the title.
+	PlayoffSpots uint
+	// How output is formatted, see RankingOutputFormatNames and
+	// DiffOutputFormatNames. Empty means text.
+	OutputFormat string
+	// Pad text rankings into columns, e.g. for a terminal.
+	AlignText bool
+	// Lines of a Go text/template, which rankings are rendered through
+	// instead of the output format.
+	TemplateRows []string
+	// The language of ranking output, see LocaleNames, for the point suffix,
+	// table headers, ordinals and numbers. Empty means English.
+	Locale string
+	// How teams level on points are ranked, see RankingStyleNames. Empty means
+	// standard competition ranking, e.g. 1224.
+	RankingStyle string
+	// Prefixes ranks shared by more than one team in ranking output, e.g. T
+	// or =. Empty marks none.
+	TieMarker string
+	// Where each input row came from, e.g. a file and line, for errors. Empty
+	// means rows are referred to by their position, counting from 1.
+	InputSources []string
+}
+
+// Table is rankings as a header per column, and a row of cells per team.
+type Table struct {
+	Columns []string
+	Cells   [][]string
+}
+
+// SimulationOptions customise SimulateSeasons.
+type SimulationOptions struct {
+	// Games remaining in the season.
+	FixtureRows []string
+	// How many seasons to simulate. Zero means the league default.
+	Seasons int
+	// Simulations with the same seed give the same results.
+	Seed int64
+	// Treat team A of each game and fixture as the home team, and account for
+	// home advantage.
+	HomeAdvantage bool
+}
+
+// PredictionOptions customise PredictFixtures.
+type PredictionOptions struct {
+	// Games to predict.
+	FixtureRows []string
+	// Treat team A of each game and fixture as the home team, and account for
+	// home advantage.
+	HomeAdvantage bool
+}
+
+// WhatIfOptions customise EvaluateWhatIf.
+type WhatIfOptions struct {
+	// Games which might be played.
+	HypotheticalRows []string
+	// Where each hypothetical row came from, as for Options.InputSources.
+	HypotheticalSources []string
+}
+
+// DiffOptions customise DiffResults.
+type DiffOptions struct {
+	// Results to compare the input against.
+	PreviousRows []string
+	// Where each previous row came from, as for Options.InputSources.
+	PreviousSources []string
+}
+
+// DefaultOptions match the behaviour of the league package defaults.
+func DefaultOptions() Options {
+	return Options{}
+}
+
+// The names of sports which may be given in Options.
+func SportNames() []string {
+	return league.PresetNames()
 }
 
 type RowIOGatewayImpl struct {
@@ -37,40 +222,196 @@ func NewRowIOGatewayImpl(usecaseSvc usecase.Service) *RowIOGatewayImpl {
 	}
 }
 
-func (riogi *RowIOGatewayImpl) CalculateRankings(rows []string) ([]string, error) {
-	gameResults, err := riogi.convertInput(rows)
+func (riogi *RowIOGatewayImpl) CalculateRankings(rows []string, opts Options) ([]string, error) {
+	outputFormat, err := riogi.convertOutputFormat(opts.OutputFormat, RankingOutputFormatNames())
+	if err != nil {
+		return nil, err
+	}
+	loc, err := riogi.convertLocale(opts.Locale)
 	if err != nil {
 		return nil, err
 	}
+	table, err := riogi.calculateTable(rows, opts, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.TemplateRows) > 0 {
+		return riogi.renderTemplate(table, opts.TemplateRows, loc)
+	}
+	return riogi.renderTable(table, outputFormat, opts.AlignText, loc), nil
+}
+
+func (riogi *RowIOGatewayImpl) TabulateRankings(rows []string, opts Options) (Table, error) {
+	loc, err := riogi.convertLocale(opts.Locale)
+	if err != nil {
+		return Table{}, err
+	}
+	table, err := riogi.calculateTable(rows, opts, loc)
+	if err != nil {
+		return Table{}, err
+	}
+
+	columns := make([]string, len(table.columns))
+	for i, column := range table.columns {
+		columns[i] = column.header
+	}
+	return Table{Columns: columns, Cells: table.cells}, nil
+}
+
+func (riogi *RowIOGatewayImpl) calculateTable(rows []string, opts Options, loc locale) (rankingTable, error) {
+	gameResults, err := riogi.convertInput(rows, opts.InputSources, opts)
+	if err != nil {
+		return rankingTable{}, err
+	}
+	// Every method ranks teams level on its value the same way.
+	style, err := riogi.convertRankingStyle(opts.RankingStyle)
+	if err != nil {
+		return rankingTable{}, err
+	}
+
+	rankBy := strings.ToLower(strings.TrimSpace(opts.RankBy))
+	// Clinched and eliminated teams are only worked out from points.
+	if len(opts.FixtureRows) > 0 && rankBy != "" && rankBy != rankByPoints {
+		return rankingTable{}, fmt.Errorf("%s - %w", opts.RankBy, ErrFixturesNeedPoints)
+	}
+
+	var table rankingTable
+	switch rankBy {
+	case "", rankByPoints:
+		table, err = riogi.calculatePointsRankings(gameResults, opts, loc)
+		if err != nil {
+			return rankingTable{}, err
+		}
+	case rankByGlicko2:
+		table = riogi.calculateGlicko2Rankings(gameResults, style, opts, loc)
+	case rankByBradleyTerry:
+		table = riogi.calculateBradleyTerryRankings(gameResults, style, opts, loc)
+	case rankByColley:
+		ratings := riogi.usecaseSvc.CalculateColleyRatings(gameResults, league.MatrixOptions{RankingStyle: style})
+		table = riogi.calculateMatrixRankings(gameResults, ratings, 3, opts, loc)
+	case rankByMassey:
+		ratings := riogi.usecaseSvc.CalculateMasseyRatings(gameResults, league.MatrixOptions{RankingStyle: style})
+		table = riogi.calculateMatrixRankings(gameResults, ratings, 2, opts, loc)
+	default:
+		return rankingTable{}, fmt.Errorf("%s - %w, expected one of: %s",
+			opts.RankBy, ErrUnknownRankBy, strings.Join(RankByNames(), ", "))
+	}
+	return table, nil
+}
 
-	rankings := riogi.usecaseSvc.CalculateRankings(gameResults)
+func (riogi *RowIOGatewayImpl) calculatePointsRankings(
+	gameResults []league.GameResult,
+	opts Options,
+	loc locale,
+) (rankingTable, error) {
+	leagueOpts, err := riogi.convertOptions(opts)
+	if err != nil {
+		return rankingTable{}, err
+	}
+
+	rankings := riogi.usecaseSvc.CalculateRankings(gameResults, leagueOpts)
+	schedules := riogi.calculateStrengthOfSchedule(gameResults, opts, league.PointsPerGame(rankings))
+	ranges, err := riogi.calculatePositionRanges(gameResults, opts, leagueOpts)
+	if err != nil {
+		return rankingTable{}, err
+	}
 
-	return riogi.convertOutput(rankings), nil
+	return riogi.convertOutput(rankings, schedules, ranges, opts, leagueOpts.Metric, loc), nil
 }
 
-func (riogi *RowIOGatewayImpl) convertInput(rows []string) ([]league.GameResult, error) {
-	gameResults := make([]league.GameResult, len(rows))
+func (riogi *RowIOGatewayImpl) ValidateInput(rows []string, opts Options) error {
+	_, err := riogi.convertInput(rows, opts.InputSources, opts)
+	return err
+}
+
+// Sources are where each row came from, e.g. a file and line, for errors.
+// Rows beyond the sources given are referred to by their index.
+func (riogi *RowIOGatewayImpl) convertInput(rows []string, sources []string, opts Options) ([]league.GameResult, error) {
+	gameResults := make([]league.GameResult, 0, len(rows))
+	round, date := uint(0), time.Time{}
 	for i, row := range rows {
-		gameResult, err := riogi.convertInputRow(row)
+		// Header rows apply to the game rows which follow them.
+		isHeader, err := riogi.convertHeaderRow(row, &round, &date)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert %s: %w", riogi.describeInputRow(i, sources), err)
+		}
+		if isHeader {
+			continue
+		}
+
+		gameResult, err := riogi.convertInputRow(row, opts)
 		if err != nil {
-			return nil, fmt.Errorf("could not convert row %d of input: %w", i, err)
+			return nil, fmt.Errorf("could not convert %s: %w", riogi.describeInputRow(i, sources), err)
 		}
 
-		gameResults[i] = gameResult
+		gameResult.Round = round
+		gameResult.Date = date
+		gameResults = append(gameResults, gameResult)
 	}
 	return gameResults, nil
 }
 
+func (riogi *RowIOGatewayImpl) describeInputRow(i int, sources []string) string {
+	if i < len(sources) {
+		return fmt.Sprintf("row at %s", sources[i])
+	}
+	return fmt.Sprintf("row %d of input", i+1)
+}
+
+const (
+	roundHeaderPrefix = "round "
+	dateHeaderLayout  = "2006-01-02"
+)
+
+func (riogi *RowIOGatewayImpl) convertHeaderRow(row string, round *uint, date *time.Time) (bool, error) {
+	// Game rows always have a comma, which headers never do.
+	cleaned := strings.TrimSpace(row)
+	if cleaned == "" || strings.Contains(cleaned, rowSplitStr) {
+		return false, nil
+	}
+
+	if strings.HasPrefix(strings.ToLower(cleaned), roundHeaderPrefix) {
+		roundStr := strings.TrimSpace(cleaned[len(roundHeaderPrefix):])
+		parsed, err := strconv.ParseUint(roundStr, 10, 0)
+		if err != nil {
+			return false, fmt.Errorf("round is not a positive integer [%s]: %w", roundStr, ErrMalformedRow)
+		}
+
+		*round = uint(parsed)
+		return true, nil
+	}
+
+	if parsed, err := time.Parse(dateHeaderLayout, cleaned); err == nil {
+		*date = parsed
+		return true, nil
+	}
+	return false, nil
+}
+
 const (
-	rowSplitStr  = ","
-	sideSplitStr = " "
+	rowSplitStr     = ","
+	sideSplitStr    = " "
+	walkoverMarker  = "W/O"
+	annotationOpen  = "("
+	annotationClose = ")"
+	abandonedMarker = "abandoned"
+	overtimeMarker  = "ot"
+	shootoutMarker  = "so"
 )
 
-func (riogi *RowIOGatewayImpl) convertInputRow(row string) (league.GameResult, error) {
+func (riogi *RowIOGatewayImpl) convertInputRow(row string, opts Options) (league.GameResult, error) {
 	if strings.TrimSpace(row) == "" {
 		return league.GameResult{}, fmt.Errorf("empty string: %w", ErrMalformedRow)
 	}
 
+	// Strip any trailing annotation, which determines the status or decision of the game.
+	row, annotation := riogi.splitAnnotation(row)
+	status, decision, err := riogi.convertAnnotation(annotation)
+	if err != nil {
+		return league.GameResult{}, err
+	}
+
 	// Split into two sides, then parse each side.
 	sides := strings.Split(row, rowSplitStr)
 	if len(sides) != 2 {
@@ -78,27 +419,126 @@ func (riogi *RowIOGatewayImpl) convertInputRow(row string) (league.GameResult, e
 			len(sides), ErrMalformedRow)
 	}
 
-	teamA, scoreA, err := riogi.convertInputRowSide(sides[0])
+	// Walkovers have no scores to parse.
+	teamA, walkoverA := riogi.trimWalkover(sides[0])
+	teamB, walkoverB := riogi.trimWalkover(sides[1])
+	if walkoverA || walkoverB {
+		return riogi.convertWalkoverRow(teamA, walkoverA, teamB, walkoverB, annotation)
+	}
+
+	teamA, scoreA, err := riogi.convertInputRowSide(sides[0], status, opts)
 	if err != nil {
 		return league.GameResult{}, fmt.Errorf("first side: %w", err)
 	}
 
-	teamB, scoreB, err := riogi.convertInputRowSide(sides[1])
+	teamB, scoreB, err := riogi.convertInputRowSide(sides[1], status, opts)
 	if err != nil {
 		return league.GameResult{}, fmt.Errorf("second side: %w", err)
 	}
 
+	if decision != league.DecisionRegulation && scoreA == scoreB {
+		return league.GameResult{}, fmt.Errorf("a game decided after regulation cannot be a draw: %w", ErrMalformedRow)
+	}
+
+	return league.GameResult{
+		TeamA:    teamA,
+		ScoreA:   scoreA,
+		TeamB:    teamB,
+		ScoreB:   scoreB,
+		Status:   status,
+		Decision: decision,
+	}, nil
+}
+
+func (riogi *RowIOGatewayImpl) splitAnnotation(row string) (string, string) {
+	cleaned := strings.TrimSpace(row)
+	if !strings.HasSuffix(cleaned, annotationClose) {
+		return row, ""
+	}
+
+	openIdx := strings.LastIndex(cleaned, annotationOpen)
+	if openIdx < 0 {
+		return row, ""
+	}
+
+	annotation := cleaned[openIdx+len(annotationOpen) : len(cleaned)-len(annotationClose)]
+	return cleaned[:openIdx], strings.TrimSpace(annotation)
+}
+
+func (riogi *RowIOGatewayImpl) convertAnnotation(annotation string) (league.ResultStatus, league.Decision, error) {
+	switch strings.ToLower(annotation) {
+	case "":
+		return league.StatusPlayed, league.DecisionRegulation, nil
+	case abandonedMarker:
+		return league.StatusAbandoned, league.DecisionRegulation, nil
+	case overtimeMarker:
+		return league.StatusPlayed, league.DecisionOvertime, nil
+	case shootoutMarker:
+		return league.StatusPlayed, league.DecisionShootout, nil
+	default:
+		return league.StatusPlayed, league.DecisionRegulation,
+			fmt.Errorf("unknown annotation [%s]: %w", annotation, ErrMalformedRow)
+	}
+}
+
+func (riogi *RowIOGatewayImpl) trimWalkover(side string) (string, bool) {
+	cleaned := strings.TrimSpace(side)
+	if len(cleaned) < len(walkoverMarker) {
+		return cleaned, false
+	}
+
+	// Allow any case, e.g. "w/o".
+	markerIdx := len(cleaned) - len(walkoverMarker)
+	if !strings.EqualFold(cleaned[markerIdx:], walkoverMarker) {
+		return cleaned, false
+	}
+	return strings.TrimSpace(cleaned[:markerIdx]), true
+}
+
+func (riogi *RowIOGatewayImpl) convertWalkoverRow(
+	teamA string,
+	walkoverA bool,
+	teamB string,
+	walkoverB bool,
+	annotation string,
+) (league.GameResult, error) {
+	if walkoverA && walkoverB {
+		return league.GameResult{}, fmt.Errorf("only one side may be awarded a walkover: %w", ErrMalformedRow)
+	}
+	if annotation != "" {
+		return league.GameResult{}, fmt.Errorf("a walkover cannot also be annotated: %w", ErrMalformedRow)
+	}
+	if teamA == "" || teamB == "" {
+		return league.GameResult{}, fmt.Errorf("a walkover requires both team names: %w", ErrMalformedRow)
+	}
+	// Otherwise, the score would be taken as part of the team's name.
+	if riogi.hasScore(teamA) || riogi.hasScore(teamB) {
+		return league.GameResult{}, fmt.Errorf("a walkover cannot also have a score: %w", ErrMalformedRow)
+	}
+
+	status := league.StatusAwardedA
+	if walkoverB {
+		status = league.StatusAwardedB
+	}
 	return league.GameResult{
 		TeamA:  teamA,
-		ScoreA: scoreA,
 		TeamB:  teamB,
-		ScoreB: scoreB,
+		Status: status,
 	}, nil
 }
 
-func (riogi *RowIOGatewayImpl) convertInputRowSide(side string) (string, int, error) {
+func (riogi *RowIOGatewayImpl) convertInputRowSide(
+	side string,
+	status league.ResultStatus,
+	opts Options,
+) (string, int, error) {
 	cleaned := strings.TrimSpace(side)
 
+	// Abandoned games may omit the scores, in which case the whole side is the team.
+	if status == league.StatusAbandoned && !riogi.hasScore(cleaned) {
+		return cleaned, 0, nil
+	}
+
 	// Since the name of the team may contain the split string, we only want to split on the LAST occurrence.
 	lastSpaceIdx := strings.LastIndex(cleaned, sideSplitStr)
 	if lastSpaceIdx < 0 {
@@ -112,32 +552,153 @@ func (riogi *RowIOGatewayImpl) convertInputRowSide(side string) (string, int, er
 	if err != nil {
 		return "", 0, fmt.Errorf("score is not an integer [%s]: %w", scoreStr, ErrMalformedRow)
 	}
+	if err := riogi.checkScoreBounds(score, opts); err != nil {
+		return "", 0, err
+	}
 
 	return team, score, nil
 }
 
-func (riogi *RowIOGatewayImpl) convertOutput(rankings []league.Ranking) []string {
-	rows := make([]string, len(rankings))
-	for i, ranking := range rankings {
-		rows[i] = riogi.convertOutputRanking(ranking)
+func (riogi *RowIOGatewayImpl) hasScore(cleanedSide string) bool {
+	lastSpaceIdx := strings.LastIndex(cleanedSide, sideSplitStr)
+	if lastSpaceIdx < 0 {
+		return false
 	}
-	return rows
+	_, err := strconv.Atoi(cleanedSide[lastSpaceIdx+1:])
+	return err == nil
 }
 
-func (riogi *RowIOGatewayImpl) convertOutputRanking(ranking league.Ranking) string {
-	pointSuffix := riogi.determinePointSuffix(ranking.Points)
-	return fmt.Sprintf("%d. %s, %d %s",
-		ranking.Rank, ranking.Team, ranking.Points, pointSuffix)
+func (riogi *RowIOGatewayImpl) checkScoreBounds(score int, opts Options) error {
+	if score < 0 && !opts.AllowNegativeScores {
+		return fmt.Errorf("score is negative [%d]: %w", score, ErrMalformedRow)
+	}
+	if opts.MaxScore > 0 && score > opts.MaxScore {
+		return fmt.Errorf("score exceeds the maximum of %d [%d]: %w", opts.MaxScore, score, ErrMalformedRow)
+	}
+	return nil
 }
 
-const (
-	pluralFormPointSuffix   = "pts"
-	singularFormPointSuffix = "pt"
-)
+func (riogi *RowIOGatewayImpl) convertOptions(opts Options) (league.Options, error) {
+	adjustments, err := riogi.convertAdjustments(opts.AdjustmentRows)
+	if err != nil {
+		return league.Options{}, err
+	}
+
+	// Start from the sport, then customise it.
+	leagueOpts, err := riogi.convertSport(opts.Sport)
+	if err != nil {
+		return league.Options{}, err
+	}
+	leagueOpts.Scoring, err = riogi.convertRules(opts.RulesRows, leagueOpts.Scoring)
+	if err != nil {
+		return league.Options{}, err
+	}
+	if opts.ForfeitWinPoints != nil {
+		leagueOpts.Scoring.ForfeitWinPoints = *opts.ForfeitWinPoints
+	}
+	if opts.ForfeitLosePoints != nil {
+		leagueOpts.Scoring.ForfeitLosePoints = *opts.ForfeitLosePoints
+	}
+	leagueOpts.Adjustments = adjustments
+	leagueOpts.RankingStyle, err = riogi.convertRankingStyle(opts.RankingStyle)
+	if err != nil {
+		return league.Options{}, err
+	}
+	return leagueOpts, nil
+}
+
+func (riogi *RowIOGatewayImpl) convertSport(sport string) (league.Options, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(sport))
+	if cleaned == "" {
+		return league.DefaultOptions(), nil
+	}
+
+	preset, ok := league.LookupPreset(cleaned)
+	if !ok {
+		return league.Options{}, fmt.Errorf("%s - %w, expected one of: %s",
+			sport, ErrUnknownSport, strings.Join(SportNames(), ", "))
+	}
+	return preset.Options, nil
+}
+
+func (riogi *RowIOGatewayImpl) convertOutput(
+	rankings []league.Ranking,
+	schedules map[string]league.StrengthOfSchedule,
+	ranges map[string]league.PositionRange,
+	opts Options,
+	metric league.Metric,
+	loc locale,
+) rankingTable {
+	valueHeader := loc.messages.pointsHeader
+	if metric == league.MetricWinPercentage {
+		valueHeader = loc.messages.winPercentageHeader
+	}
+	table := rankingTable{
+		columns: append([]tableColumn{
+			{header: loc.messages.rankHeader, numeric: true},
+			{header: loc.messages.teamHeader},
+			{header: valueHeader, numeric: true},
+		}, riogi.strengthOfScheduleColumns(schedules, loc)...),
+	}
+	shared := riogi.determineSharedRanks(len(rankings), func(i int) uint { return rankings[i].Rank })
+	for _, ranking := range rankings {
+		cells := append([]string{
+			riogi.formatRank(ranking.Rank, shared, opts),
+			riogi.convertOutputTeam(ranking, ranges, opts),
+			riogi.formatRankingNumber(ranking, metric, loc),
+		}, riogi.strengthOfScheduleCells(schedules, ranking.Team, 2, loc)...)
+		row := riogi.convertOutputRankingRow(ranking, ranges, shared, opts, metric, loc)
+		table.addRow(riogi.addStrengthOfSchedule(row, schedules, ranking.Team, 2, loc), cells...)
+		table.rankings = append(table.rankings, riogi.convertTemplateRanking(ranking, schedules, ranges, shared, opts))
+	}
+
+	if opts.AnnotateAdjustments {
+		table.footnotes = riogi.convertOutputFootnotes(rankings, loc)
+	}
+	return table
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputRanking(
+	ranking league.Ranking,
+	ranges map[string]league.PositionRange,
+	shared map[uint]bool,
+	opts Options,
+	metric league.Metric,
+	loc locale,
+) string {
+	return riogi.convertOutputRankingRow(ranking, ranges, shared, opts, metric, loc).String()
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputRankingRow(
+	ranking league.Ranking,
+	ranges map[string]league.PositionRange,
+	shared map[uint]bool,
+	opts Options,
+	metric league.Metric,
+	loc locale,
+) textRow {
+	row := textRow{
+		rank: riogi.formatRank(ranking.Rank, shared, opts),
+		team: riogi.convertOutputTeam(ranking, ranges, opts),
+	}
+	if metric == league.MetricWinPercentage {
+		row.values = []string{loc.formatNumber(ranking.WinPercentage(), 3)}
+		return row
+	}
+
+	row.values = []string{loc.formatPoints(ranking.Points), loc.pointSuffix(ranking.Points)}
+	return row
+}
 
-func (riogi *RowIOGatewayImpl) determinePointSuffix(points int) string {
-	if points == 0 || points < -1 || points > 1 {
-		return pluralFormPointSuffix
+// The team's name, with any markers.
+func (riogi *RowIOGatewayImpl) convertOutputTeam(
+	ranking league.Ranking,
+	ranges map[string]league.PositionRange,
+	opts Options,
+) string {
+	team := riogi.determinePositionMarker(ranges, ranking.Team, opts) + ranking.Team
+	if opts.AnnotateAdjustments && len(ranking.Adjustments) > 0 {
+		team += footnoteMarker
 	}
-	return singularFormPointSuffix
+	return team
 }
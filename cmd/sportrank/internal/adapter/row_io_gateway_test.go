@@ -2,7 +2,9 @@ package adapter_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/adapter"
 	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/usecase"
@@ -30,32 +32,109 @@ func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsInput_InvalidCases(
 	// Setup fixture and expectations
 	cases := []struct {
 		fixture        []string
+		opts           adapter.Options
 		expectedErrMsg string
 	}{
 		// Empty case
 		{
 			[]string{""},
-			malformedRowErrMsg("could not convert row 0 of input: empty string"),
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: empty string"),
 		},
 
 		// "Side" split issues
 		{
 			[]string{"TeamA 1"},
-			malformedRowErrMsg("could not convert row 0 of input: expected 2 sections after splitting by comma but got 1"),
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: expected 2 sections after splitting by comma but got 1"),
 		},
 		{
 			[]string{"TeamA 1, TeamB 2,"},
-			malformedRowErrMsg("could not convert row 0 of input: expected 2 sections after splitting by comma but got 3"),
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: expected 2 sections after splitting by comma but got 3"),
 		},
 
 		// "Side" issues
 		{
 			[]string{"TeamA, TeamB 1"},
-			malformedRowErrMsg("could not convert row 0 of input: first side: expected a space separating team and score but found none"),
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: first side: expected a space separating team and score but found none"),
 		},
 		{
 			[]string{"TeamA 1, TeamB seven"},
-			malformedRowErrMsg("could not convert row 0 of input: second side: score is not an integer [seven]"),
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: second side: score is not an integer [seven]"),
+		},
+
+		// Score bound issues
+		{
+			[]string{"TeamA -3, TeamB 1"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: first side: score is negative [-3]"),
+		},
+		{
+			[]string{"TeamA 3, TeamB 101"},
+			adapter.Options{MaxScore: 100},
+			malformedRowErrMsg("could not convert row 1 of input: second side: score exceeds the maximum of 100 [101]"),
+		},
+
+		// Annotation and walkover issues
+		{
+			[]string{"TeamA 1, TeamB 0 (postponed)"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: unknown annotation [postponed]"),
+		},
+		{
+			[]string{"TeamA W/O, TeamB W/O"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: only one side may be awarded a walkover"),
+		},
+		{
+			[]string{"TeamA W/O, TeamB (abandoned)"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: a walkover cannot also be annotated"),
+		},
+		{
+			[]string{"TeamA W/O, TeamB (OT)"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: a walkover cannot also be annotated"),
+		},
+		{
+			[]string{"TeamA 2, TeamB 2 (OT)"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: a game decided after regulation cannot be a draw"),
+		},
+		{
+			[]string{"W/O, TeamB"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: a walkover requires both team names"),
+		},
+		{
+			[]string{"TeamA W/O, TeamB 3"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: a walkover cannot also have a score"),
+		},
+		{
+			[]string{"TeamA 3, TeamB W/O"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: a walkover cannot also have a score"),
+		},
+		{
+			[]string{"TeamA 1, TeamB"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: second side: expected a space separating team and score but found none"),
+		},
+
+		// Header issues
+		{
+			[]string{"Round one"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: round is not a positive integer [one]"),
+		},
+		{
+			[]string{"2022-13-01"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 1 of input: expected 2 sections after splitting by comma but got 1"),
 		},
 
 		// Error in one row of multiple
@@ -65,14 +144,15 @@ func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsInput_InvalidCases(
 				"",
 				"TeamA 3, TeamC 4",
 			},
-			malformedRowErrMsg("could not convert row 1 of input: empty string"),
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 2 of input: empty string"),
 		},
 	}
 
 	for i, c := range cases {
 		suite.Run(fmt.Sprintf("Test case %d", i), func() {
 			// Exercise SUT
-			_, err := suite.sut.CalculateRankings(c.fixture)
+			_, err := suite.sut.CalculateRankings(c.fixture, c.opts)
 
 			// Verify results
 			suite.mockUsecaseSvc.AssertNotCalled(suite.T(), "CalculateRankings")
@@ -85,11 +165,12 @@ func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsInput_ValidCases()
 	// Setup fixture
 	cases := []struct {
 		fixture            []string
+		opts               adapter.Options
 		expectedConversion []league.GameResult
 	}{
 		// Trivial cases
-		{nil, []league.GameResult{}},
-		{[]string{}, []league.GameResult{}},
+		{nil, adapter.Options{}, []league.GameResult{}},
+		{[]string{}, adapter.Options{}, []league.GameResult{}},
 
 		// Mixed case - all rows should pass
 		{
@@ -98,10 +179,74 @@ func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsInput_ValidCases()
 				"John Lennon 7, Paul McCartney 2",
 				" Dave Lister 1 , Arnold Rimmer 0 ",
 			},
+			adapter.Options{},
+			[]league.GameResult{
+				{TeamA: "TeamA", ScoreA: 1, TeamB: "TeamB", ScoreB: 2},
+				{TeamA: "John Lennon", ScoreA: 7, TeamB: "Paul McCartney", ScoreB: 2},
+				{TeamA: "Dave Lister", ScoreA: 1, TeamB: "Arnold Rimmer", ScoreB: 0},
+			},
+		},
+
+		// Walkovers and abandoned games
+		{
+			[]string{
+				"TeamA W/O, TeamB",
+				"Team C, Team D w/o",
+				"TeamA 1, TeamC 0 (abandoned)",
+				"TeamB, Team D (Abandoned)",
+			},
+			adapter.Options{},
+			[]league.GameResult{
+				{TeamA: "TeamA", TeamB: "TeamB", Status: league.StatusAwardedA},
+				{TeamA: "Team C", TeamB: "Team D", Status: league.StatusAwardedB},
+				{TeamA: "TeamA", ScoreA: 1, TeamB: "TeamC", ScoreB: 0, Status: league.StatusAbandoned},
+				{TeamA: "TeamB", TeamB: "Team D", Status: league.StatusAbandoned},
+			},
+		},
+
+		// Overtime and shootout decisions
+		{
+			[]string{
+				"TeamA 3, TeamB 2 (OT)",
+				"TeamA 1, TeamB 2 (so)",
+			},
+			adapter.Options{},
+			[]league.GameResult{
+				{TeamA: "TeamA", ScoreA: 3, TeamB: "TeamB", ScoreB: 2, Decision: league.DecisionOvertime},
+				{TeamA: "TeamA", ScoreA: 1, TeamB: "TeamB", ScoreB: 2, Decision: league.DecisionShootout},
+			},
+		},
+
+		// Round and date headers
+		{
+			[]string{
+				"TeamA 1, TeamB 0",
+				"Round 2",
+				"TeamA 1, TeamC 0",
+				"2022-03-01",
+				"Round Robin FC 1, TeamC 0",
+				"round 3",
+				"TeamB 1, TeamC 0",
+			},
+			adapter.Options{},
+			[]league.GameResult{
+				{TeamA: "TeamA", ScoreA: 1, TeamB: "TeamB", ScoreB: 0},
+				{TeamA: "TeamA", ScoreA: 1, TeamB: "TeamC", ScoreB: 0, Round: 2},
+				{TeamA: "Round Robin FC", ScoreA: 1, TeamB: "TeamC", ScoreB: 0, Round: 2,
+					Date: time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)},
+				{TeamA: "TeamB", ScoreA: 1, TeamB: "TeamC", ScoreB: 0, Round: 3,
+					Date: time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+
+		// Score bounds are respected when configured
+		{
+			[]string{
+				"TeamA -3, TeamB 100",
+			},
+			adapter.Options{AllowNegativeScores: true, MaxScore: 100},
 			[]league.GameResult{
-				{"TeamA", 1, "TeamB", 2},
-				{"John Lennon", 7, "Paul McCartney", 2},
-				{"Dave Lister", 1, "Arnold Rimmer", 0},
+				{TeamA: "TeamA", ScoreA: -3, TeamB: "TeamB", ScoreB: 100},
 			},
 		},
 	}
@@ -110,11 +255,11 @@ func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsInput_ValidCases()
 		suite.Run(fmt.Sprintf("Test case %d", i), func() {
 			// Setup mocks
 			mockCall := suite.mockUsecaseSvc.Mock.
-				On("CalculateRankings", c.expectedConversion).
+				On("CalculateRankings", c.expectedConversion, mock.Anything).
 				Return(nil)
 
 			// Exercise SUT
-			_, err := suite.sut.CalculateRankings(c.fixture)
+			_, err := suite.sut.CalculateRankings(c.fixture, c.opts)
 
 			// Verify results
 			suite.mockUsecaseSvc.AssertExpectations(suite.T())
@@ -139,12 +284,14 @@ func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsOutput() {
 		// Mixed case - all rows should transform as expected
 		{
 			[]league.Ranking{
-				{1, "John", 10},
-				{1, "Paul", 10},
-				{3, "George", 1},
-				{4, "Ringo", 0},
-				{5, "Peter", -1},
-				{6, "Bob", -5},
+				{Rank: 1, Team: "John", Points: 10},
+				{Rank: 1, Team: "Paul", Points: 10},
+				{Rank: 3, Team: "George", Points: 1},
+				{Rank: 4, Team: "Ringo", Points: 0},
+				{Rank: 5, Team: "Peter", Points: -1},
+				{Rank: 6, Team: "Bob", Points: -5},
+				{Rank: 7, Team: "Garry", Points: 0.5},
+				{Rank: 8, Team: "Magnus", Points: 1.5},
 			},
 			[]string{
 				"1. John, 10 pts",
@@ -153,6 +300,8 @@ func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsOutput() {
 				"4. Ringo, 0 pts",
 				"5. Peter, -1 pt",
 				"6. Bob, -5 pts",
+				"7. Garry, 0.5 pts",
+				"8. Magnus, 1.5 pts",
 			},
 		},
 	}
@@ -161,11 +310,11 @@ func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsOutput() {
 		suite.Run(fmt.Sprintf("Test case %d", i), func() {
 			// Setup mocks
 			mockCall := suite.mockUsecaseSvc.Mock.
-				On("CalculateRankings", mock.Anything).
+				On("CalculateRankings", mock.Anything, mock.Anything).
 				Return(c.mockOutput)
 
 			// Exercise SUT
-			actual, err := suite.sut.CalculateRankings(nil)
+			actual, err := suite.sut.CalculateRankings(nil, adapter.Options{})
 
 			// Verify results
 			suite.NoError(err)
@@ -177,6 +326,1649 @@ func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsOutput() {
 	}
 }
 
-func malformedRowErrMsg(start string) string {
-	return fmt.Sprintf("%s: %s", start, adapter.ErrMalformedRow.Error())
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_ShouldPassOnScoringOptions() {
+	// Setup expectations
+	expectedOpts := league.DefaultOptions()
+	expectedOpts.Scoring.ForfeitWinPoints = 2
+	expectedOpts.Scoring.ForfeitLosePoints = -1
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", mock.Anything, expectedOpts).
+		Return(nil)
+
+	// Exercise SUT
+	_, err := suite.sut.CalculateRankings(nil, adapter.Options{ForfeitWinPoints: floatPtr(2), ForfeitLosePoints: floatPtr(-1)})
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsAdjustments_InvalidCases() {
+	// Setup fixture and expectations
+	cases := []struct {
+		fixture        []string
+		expectedErrMsg string
+	}{
+		{
+			[]string{""},
+			malformedAdjustmentErrMsg("could not convert row 1 of adjustments: empty string"),
+		},
+		{
+			[]string{"Lions"},
+			malformedAdjustmentErrMsg("could not convert row 1 of adjustments: expected a space separating team and points but found none"),
+		},
+		{
+			[]string{"Lions -3", "Lions three"},
+			malformedAdjustmentErrMsg("could not convert row 2 of adjustments: points is not a number [three]"),
+		},
+		{
+			[]string{"Lions NaN"},
+			malformedAdjustmentErrMsg("could not convert row 1 of adjustments: points is not a finite number [NaN]"),
+		},
+		{
+			[]string{"Lions -3", "Snakes Inf"},
+			malformedAdjustmentErrMsg("could not convert row 2 of adjustments: points is not a finite number [Inf]"),
+		},
+		{
+			[]string{"Lions +Inf \"bonus\""},
+			malformedAdjustmentErrMsg("could not convert row 1 of adjustments: points is not a finite number [+Inf]"),
+		},
+		{
+			[]string{`Lions -3"`},
+			malformedAdjustmentErrMsg("could not convert row 1 of adjustments: reason is missing an opening quote"),
+		},
+	}
+
+	for i, c := range cases {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Exercise SUT
+			_, err := suite.sut.CalculateRankings(nil, adapter.Options{AdjustmentRows: c.fixture})
+
+			// Verify results
+			suite.mockUsecaseSvc.AssertNotCalled(suite.T(), "CalculateRankings")
+			suite.EqualError(err, c.expectedErrMsg)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsAdjustments_ValidCase() {
+	// Setup fixture
+	optsFixture := adapter.Options{
+		AdjustmentRows: []string{
+			`Lions -3 "financial breach"`,
+			" FC Awesome +1 ",
+			`Snakes 2 ""`,
+		},
+	}
+
+	// Setup expectations
+	expectedOpts := league.DefaultOptions()
+	expectedOpts.Adjustments = []league.Adjustment{
+		{Team: "Lions", Points: -3, Reason: "financial breach"},
+		{Team: "FC Awesome", Points: 1},
+		{Team: "Snakes", Points: 2},
+	}
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", mock.Anything, expectedOpts).
+		Return(nil)
+
+	// Exercise SUT
+	_, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsOutput_GivenAnnotateAdjustments() {
+	// Setup fixture
+	mockOutput := []league.Ranking{
+		{Rank: 1, Team: "John", Points: 10},
+		{Rank: 2, Team: "Paul", Points: 7, Adjustments: []league.Adjustment{
+			{Team: "Paul", Points: -3, Reason: "financial breach"},
+			{Team: "Paul", Points: 1},
+		}},
+	}
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", mock.Anything, mock.Anything).
+		Return(mockOutput)
+
+	// Setup expectations
+	expected := []string{
+		"1. John, 10 pts",
+		"2. Paul*, 7 pts",
+		"",
+		"* Paul: -3 pts (financial breach)",
+		"* Paul: +1 pt",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{AnnotateAdjustments: true})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsRules_InvalidCases() {
+	// Setup fixture and expectations
+	cases := []struct {
+		fixture        []string
+		expectedErrMsg string
+	}{
+		{
+			[]string{"win: four"},
+			malformedRulesErrMsg("could not decode rules: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!str `four` into float64"),
+		},
+		{
+			[]string{"wins: 4"},
+			malformedRulesErrMsg("could not decode rules: yaml: unmarshal errors:\n  line 1: field wins not found in type adapter.rulesDTO"),
+		},
+		{
+			[]string{"bonuses:", "  - points: 1", "    outcome: victory"},
+			malformedRulesErrMsg("could not convert bonus 0 of rules: unknown outcome [victory]"),
+		},
+	}
+
+	for i, c := range cases {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Exercise SUT
+			_, err := suite.sut.CalculateRankings(nil, adapter.Options{RulesRows: c.fixture})
+
+			// Verify results
+			suite.mockUsecaseSvc.AssertNotCalled(suite.T(), "CalculateRankings")
+			suite.EqualError(err, c.expectedErrMsg)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsRules_ValidCase() {
+	// Setup fixture
+	optsFixture := adapter.DefaultOptions()
+	optsFixture.RulesRows = []string{
+		"win: 4",
+		"draw: 2",
+		"overtime:",
+		"  win: 3",
+		"  loss: 1",
+		"bonuses:",
+		"  - name: try bonus",
+		"    points: 1",
+		"    min_score: 4",
+		"  - name: losing bonus",
+		"    points: 1",
+		"    outcome: Loss",
+		"    min_margin: -7",
+	}
+
+	// Setup expectations
+	minScore, minMargin := 4, -7
+	expectedOpts := league.DefaultOptions()
+	expectedOpts.Scoring.WinPoints = 4
+	expectedOpts.Scoring.DrawPoints = 2
+	expectedOpts.Scoring.Overtime = &league.DecisionPoints{WinPoints: 3, LosePoints: 1}
+	expectedOpts.Scoring.Bonuses = []league.BonusRule{
+		{Name: "try bonus", Points: 1, MinScore: &minScore},
+		{Name: "losing bonus", Points: 1, Outcome: league.OutcomeLoss, MinMargin: &minMargin},
+	}
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", mock.Anything, expectedOpts).
+		Return(nil)
+
+	// Exercise SUT
+	_, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenUnknownSport_ShouldFail() {
+	// Exercise SUT
+	_, err := suite.sut.CalculateRankings(nil, adapter.Options{Sport: "quidditch"})
+
+	// Verify results
+	suite.mockUsecaseSvc.AssertNotCalled(suite.T(), "CalculateRankings")
+	suite.ErrorIs(err, adapter.ErrUnknownSport)
+	suite.EqualError(err, "quidditch - unknown sport, expected one of: basketball, chess, football, hockey, rugby-union")
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenSport_ShouldCustomiseByForfeitPoints() {
+	// Setup expectations
+	preset, _ := league.LookupPreset("chess")
+	expectedOpts := preset.Options
+	expectedOpts.Scoring.ForfeitLosePoints = -1
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", mock.Anything, expectedOpts).
+		Return(nil)
+
+	// Exercise SUT
+	_, err := suite.sut.CalculateRankings(nil, adapter.Options{Sport: " Chess ", ForfeitLosePoints: floatPtr(-1)})
+
+	// Verify results
+	suite.NoError(err)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankingsOutput_GivenWinPercentageSport() {
+	// Setup fixture
+	mockOutput := []league.Ranking{
+		{Rank: 1, Team: "Bulls", Points: 2, Played: 3, Won: 2, Lost: 1},
+		{Rank: 2, Team: "Celtics", Points: 0, Played: 1, Lost: 1},
+	}
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", mock.Anything, mock.Anything).
+		Return(mockOutput)
+
+	// Setup expectations
+	expected := []string{
+		"1. Bulls, 0.667",
+		"2. Celtics, 0.000",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{Sport: "basketball"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenUnknownRankBy_ShouldFail() {
+	// Exercise SUT
+	_, err := suite.sut.CalculateRankings(nil, adapter.Options{RankBy: "vibes"})
+
+	// Verify results
+	suite.ErrorIs(err, adapter.ErrUnknownRankBy)
+	suite.EqualError(err, "vibes - unknown ranking method, expected one of: "+strings.Join(adapter.RankByNames(), ", "))
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenRankByGlicko2() {
+	// Setup expectations
+	expectedOpts := league.DefaultGlicko2Options()
+	expectedOpts.PeriodLength = 24 * time.Hour
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateGlicko2Ratings", []league.GameResult{}, expectedOpts).
+		Return([]league.Glicko2Rating{
+			{Rank: 1, Team: "Lions", Rating: 1662.31, Deviation: 290.3, Volatility: 0.059999},
+			{Rank: 2, Team: "Snakes", Rating: 1337.7, Deviation: 290.3, Volatility: 0.06},
+		})
+	expected := []string{
+		"1. Lions, 1662 (RD 290, vol 0.0600)",
+		"2. Snakes, 1338 (RD 290, vol 0.0600)",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{RankBy: "Glicko2", RatingPeriod: 24 * time.Hour})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+	suite.mockUsecaseSvc.AssertNotCalled(suite.T(), "CalculateRankings", mock.Anything, mock.Anything)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenRankByBradleyTerry() {
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateBradleyTerryRatings", []league.GameResult{}, league.DefaultBradleyTerryOptions()).
+		Return(league.BradleyTerryModel{
+			Ratings: []league.BradleyTerryRating{
+				{Rank: 1, Team: "Lions", Strength: 1.4142, WinProbability: 0.58579},
+				{Rank: 2, Team: "Snakes", Strength: 0.70711, WinProbability: 0.41421},
+			},
+		})
+	expected := []string{
+		"1. Lions, 1.414 (58.6% vs average)",
+		"2. Snakes, 0.707 (41.4% vs average)",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{RankBy: "bradley-terry"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenRankByMatrixMethod() {
+	var tests = []struct {
+		rankBy   string
+		method   string
+		expected []string
+	}{
+		{
+			"colley",
+			"CalculateColleyRatings",
+			[]string{
+				"1. Lions, 0.786",
+				"2. Snakes, 0.214",
+			},
+		},
+		{
+			"Massey",
+			"CalculateMasseyRatings",
+			[]string{
+				"1. Lions, 0.79",
+				"2. Snakes, 0.21",
+			},
+		},
+	}
+
+	for i, test := range tests {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Setup expectations
+			suite.mockUsecaseSvc.Mock.
+				On(test.method, []league.GameResult{}, league.DefaultMatrixOptions()).
+				Return([]league.MatrixRating{
+					{Rank: 1, Team: "Lions", Rating: 0.78571},
+					{Rank: 2, Team: "Snakes", Rating: 0.21429},
+				})
+
+			// Exercise SUT
+			actual, err := suite.sut.CalculateRankings(nil, adapter.Options{RankBy: test.rankBy})
+
+			// Verify results
+			suite.NoError(err)
+			suite.Equal(test.expected, actual)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenMarkdown() {
+	// Setup fixture
+	optsFixture := adapter.Options{
+		OutputFormat:        "Markdown",
+		AdjustmentRows:      []string{`Snakes -1 "Fielded an *ineligible* player"`},
+		AnnotateAdjustments: true,
+	}
+	adjustments := []league.Adjustment{{Team: "Snakes", Points: -1, Reason: "Fielded an *ineligible* player"}}
+
+	// Setup expectations
+	expectedOpts := league.DefaultOptions()
+	expectedOpts.Adjustments = adjustments
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, expectedOpts).
+		Return([]league.Ranking{
+			{Rank: 1, Team: "Lions | Co", Points: 3},
+			{Rank: 2, Team: "Snakes", Points: -1, Adjustments: adjustments},
+		})
+	expected := []string{
+		"| Rank | Team | Pts |",
+		"| ---: | --- | ---: |",
+		`| 1 | Lions \| Co | 3 |`,
+		`| 2 | Snakes\* | -1 |`,
+		"",
+		`\* Snakes: -1 pt (Fielded an \*ineligible\* player)`,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenMarkdownAndRankByGlicko2() {
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateGlicko2Ratings", []league.GameResult{}, league.DefaultGlicko2Options()).
+		Return([]league.Glicko2Rating{{Rank: 1, Team: "Lions", Rating: 1662.31, Deviation: 290.32, Volatility: 0.05999}})
+	expected := []string{
+		"| Rank | Team | Rating | RD | Volatility |",
+		"| ---: | --- | ---: | ---: | ---: |",
+		"| 1 | Lions | 1662 | 290 | 0.0600 |",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{RankBy: "glicko2", OutputFormat: "markdown"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenHTML() {
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return([]league.Ranking{
+			{Rank: 1, Team: "<Lions> & Co", Points: 1.5},
+		})
+	expected := []string{
+		"<!DOCTYPE html>",
+		`<html lang="en">`,
+		"<head>",
+		`<meta charset="utf-8">`,
+		"<title>Standings</title>",
+		"<style>",
+		"table { border-collapse: collapse; }",
+		"th, td { padding: 0.25em 0.75em; text-align: left; }",
+		".numeric { text-align: right; }",
+		"</style>",
+		"</head>",
+		"<body>",
+		"<table>",
+		"<thead>",
+		`<tr><th class="numeric">Rank</th><th>Team</th><th class="numeric">Pts</th></tr>`,
+		"</thead>",
+		"<tbody>",
+		`<tr><td class="numeric">1</td><td>&lt;Lions&gt; &amp; Co</td><td class="numeric">1.5</td></tr>`,
+		"</tbody>",
+		"</table>",
+		"</body>",
+		"</html>",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{OutputFormat: "html"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestTabulateRankings() {
+	// Setup fixture
+	adjustments := []league.Adjustment{{Team: "Snakes", Points: -1}}
+	optsFixture := adapter.Options{
+		AdjustmentRows:      []string{"Snakes -1"},
+		AnnotateAdjustments: true,
+		OutputFormat:        "html",
+	}
+
+	// Setup expectations
+	expectedOpts := league.DefaultOptions()
+	expectedOpts.Adjustments = adjustments
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, expectedOpts).
+		Return([]league.Ranking{
+			{Rank: 1, Team: "Lions\tCo", Points: 1.5},
+			{Rank: 2, Team: "Snakes", Points: -1, Adjustments: adjustments},
+		})
+	expected := adapter.Table{
+		Columns: []string{"Rank", "Team", "Pts"},
+		Cells: [][]string{
+			{"1", "Lions\tCo", "1.5"},
+			{"2", "Snakes*", "-1"},
+		},
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.TabulateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenUnknownOutputFormat_ShouldFail() {
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{OutputFormat: "json"})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "json - "+adapter.ErrUnknownOutputFormat.Error()+", expected one of: text, markdown, html")
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestTabulateRankings_GivenUnknownRankBy_ShouldFail() {
+	// Exercise SUT
+	actual, err := suite.sut.TabulateRankings(nil, adapter.Options{RankBy: "elo"})
+
+	// Verify results
+	suite.Equal(adapter.Table{}, actual)
+	suite.ErrorIs(err, adapter.ErrUnknownRankBy)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenAlignText() {
+	// Setup fixture
+	adjustments := []league.Adjustment{{Team: "Snakes", Points: -1}}
+	optsFixture := adapter.Options{
+		AdjustmentRows:      []string{"Snakes -1"},
+		AnnotateAdjustments: true,
+		Locale:              "de",
+		AlignText:           true,
+	}
+
+	// Setup expectations
+	expectedOpts := league.DefaultOptions()
+	expectedOpts.Adjustments = adjustments
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, expectedOpts).
+		Return([]league.Ranking{
+			{Rank: 1, Team: "Lions, Tigers & Bears", Points: 10.5},
+			{Rank: 2, Team: "Snakes", Points: -1, Adjustments: adjustments},
+		})
+	expected := []string{
+		"1. Lions, Tigers & Bears  10,5 Pkt.",
+		"2. Snakes*                  -1 Pkt.",
+		"",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual[:3])
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenTemplate() {
+	// Setup fixture
+	optsFixture := adapter.Options{
+		StrengthOfSchedule: true,
+		FixtureRows:        []string{"Snakes, Grouches"},
+		TemplateRows: []string{
+			"{{range .Rankings -}}",
+			`{{ordinal .Rank}} {{.Marker}}{{padRight 7 .Team}}|{{padLeft 4 (points .Points)}} {{pluralize .Points "pt" "pts"}}` +
+				`, {{printf "%.1f" .WinPercentage}}, SoS {{printf "%.2f" .Schedule.Opponents}}`,
+			"{{end}}",
+			"",
+			"{{- range .Columns}}[{{.}}]{{end}}",
+		},
+	}
+	rankings := []league.Ranking{
+		{Rank: 1, Team: "Lions", Points: 1.5, Played: 2, Won: 1, Drawn: 1},
+		{Rank: 2, Team: "Snakes", Points: 1, Played: 2, Won: 1, Lost: 1},
+		{Rank: 2, Team: "Grouches", Points: 1, Played: 1, Won: 1},
+	}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return(rankings)
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateStrengthOfSchedule", []league.GameResult{}, league.PointsPerGame(rankings)).
+		Return(map[string]league.StrengthOfSchedule{
+			"Lions":    {Opponents: 0.5, OpponentsOpponents: 0.75},
+			"Snakes":   {Opponents: 0.75, OpponentsOpponents: 0.625},
+			"Grouches": {Opponents: 0.5, OpponentsOpponents: 0.75},
+		})
+	suite.mockUsecaseSvc.Mock.
+		On("CalculatePositionRanges", []league.GameResult{}, []league.Fixture{{TeamA: "Snakes", TeamB: "Grouches"}},
+			league.DefaultOptions()).
+		Return(map[string]league.PositionRange{
+			"Lions":    {Best: 1, Worst: 3, Exact: true},
+			"Snakes":   {Best: 1, Worst: 3, Exact: true},
+			"Grouches": {Best: 1, Worst: 3, Exact: true},
+		})
+	expected := []string{
+		"1st Lions  | 1.5 pts, 0.8, SoS 0.50",
+		"2nd Snakes |   1 pt, 0.5, SoS 0.75",
+		"2nd Grouches|   1 pt, 1.0, SoS 0.50",
+		"[Rank][Team][Pts][SoS][Opp. opp.]",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenTemplateAndRankByMatrixMethod() {
+	// Setup fixture
+	optsFixture := adapter.Options{
+		RankBy:       "colley",
+		TemplateRows: []string{"{{range .Rows}}{{index . 1}}={{index . 2}};{{end}}{{len .Rankings}}"},
+	}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateColleyRatings", []league.GameResult{}, league.DefaultMatrixOptions()).
+		Return([]league.MatrixRating{
+			{Rank: 1, Team: "Lions", Rating: 0.78571},
+			{Rank: 2, Team: "Snakes", Rating: 0.21429},
+		})
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal([]string{"Lions=0.786;Snakes=0.214;0"}, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenTemplateOrdinals() {
+	// Setup fixture
+	optsFixture := adapter.Options{TemplateRows: []string{
+		"{{range .Rankings}}{{ordinal .Rank}} {{end}}",
+		"{{ordinal 11}} {{ordinal 12}} {{ordinal 13}} {{ordinal 21}} {{ordinal 102}} {{ordinal 111}} {{ordinal 0}}",
+	}}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return([]league.Ranking{{Rank: 1}, {Rank: 2}, {Rank: 3}, {Rank: 4}})
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal([]string{"1st 2nd 3rd 4th ", "11th 12th 13th 21st 102nd 111th 0th"}, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenMalformedTemplate_ShouldFail() {
+	// Setup fixture and expectations
+	cases := []struct {
+		template       []string
+		expectedErrMsg string
+	}{
+		{
+			[]string{"{{range .Rankings}}"},
+			"could not parse template: template: output:1: unexpected EOF: " + adapter.ErrMalformedTemplate.Error(),
+		},
+		{
+			[]string{`{{ordinal "first"}}`},
+			"could not execute template: template: output:1:2: executing \"output\" at <ordinal \"first\">: " +
+				"error calling ordinal: expected a number but got string: " + adapter.ErrMalformedTemplate.Error(),
+		},
+		{
+			[]string{"{{.Ratings}}"},
+			"could not execute template: template: output:1:2: executing \"output\" at <.Ratings>: " +
+				"can't evaluate field Ratings in type adapter.templateData: " + adapter.ErrMalformedTemplate.Error(),
+		},
+	}
+
+	for i, test := range cases {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Setup expectations
+			suite.mockUsecaseSvc.Mock.
+				On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+				Return([]league.Ranking{})
+
+			// Exercise SUT
+			actual, err := suite.sut.CalculateRankings(nil, adapter.Options{TemplateRows: test.template})
+
+			// Verify results
+			suite.Nil(actual)
+			suite.EqualError(err, test.expectedErrMsg)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenStrengthOfSchedule() {
+	// Setup fixture
+	rowsFixture := []string{
+		"Lions 3, Snakes 0",
+		"Snakes 1, Grouches 1",
+	}
+	gameResults := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Snakes", ScoreA: 1, TeamB: "Grouches", ScoreB: 1},
+	}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", gameResults, league.DefaultOptions()).
+		Return([]league.Ranking{
+			{Rank: 1, Team: "Lions", Points: 3, Played: 1},
+			{Rank: 2, Team: "Grouches", Points: 1, Played: 1},
+			{Rank: 3, Team: "Snakes", Points: 1, Played: 2},
+		})
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateStrengthOfSchedule", gameResults, map[string]float64{
+			"Lions":    3,
+			"Grouches": 1,
+			"Snakes":   0.5,
+		}).
+		Return(map[string]league.StrengthOfSchedule{
+			"Lions":    {Opponents: 0.5, OpponentsOpponents: 2},
+			"Grouches": {Opponents: 0.5, OpponentsOpponents: 2},
+			"Snakes":   {Opponents: 2, OpponentsOpponents: 0.5},
+		})
+	expected := []string{
+		"1. Lions, 3 pts, SoS 0.50 (opp. opp. 2.00)",
+		"2. Grouches, 1 pt, SoS 0.50 (opp. opp. 2.00)",
+		"3. Snakes, 1 pt, SoS 2.00 (opp. opp. 0.50)",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(rowsFixture, adapter.Options{StrengthOfSchedule: true})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenLocale() {
+	// Setup fixture and expectations
+	cases := []struct {
+		locale   string
+		expected []string
+	}{
+		{
+			"",
+			[]string{"1. Lions, 3 pts", "2. Snakes, 1.5 pts", "3. Grouches, 1 pt", "4. Bears, 0 pts"},
+		},
+		{
+			"af-ZA",
+			[]string{"1. Lions, 3 punte", "2. Snakes, 1,5 punte", "3. Grouches, 1 punt", "4. Bears, 0 punte"},
+		},
+		{
+			" DE ",
+			[]string{"1. Lions, 3 Pkt.", "2. Snakes, 1,5 Pkt.", "3. Grouches, 1 Pkt.", "4. Bears, 0 Pkt."},
+		},
+		// -> In French, counts below two are singular.
+		{
+			"fr_FR",
+			[]string{"1. Lions, 3 pts", "2. Snakes, 1,5 pt", "3. Grouches, 1 pt", "4. Bears, 0 pt"},
+		},
+	}
+
+	for i, test := range cases {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Setup expectations
+			suite.mockUsecaseSvc.Mock.
+				On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+				Return([]league.Ranking{
+					{Rank: 1, Team: "Lions", Points: 3},
+					{Rank: 2, Team: "Snakes", Points: 1.5},
+					{Rank: 3, Team: "Grouches", Points: 1},
+					{Rank: 4, Team: "Bears", Points: 0},
+				})
+
+			// Exercise SUT
+			actual, err := suite.sut.CalculateRankings(nil, adapter.Options{Locale: test.locale})
+
+			// Verify results
+			suite.NoError(err)
+			suite.Equal(test.expected, actual)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenLocaleAndMarkdown() {
+	// Setup fixture
+	adjustments := []league.Adjustment{{Team: "Snakes", Points: -1.5, Reason: "Abzug"}}
+	optsFixture := adapter.Options{
+		AdjustmentRows:      []string{`Snakes -1.5 "Abzug"`},
+		AnnotateAdjustments: true,
+		OutputFormat:        "markdown",
+		Locale:              "de",
+	}
+
+	// Setup expectations
+	expectedOpts := league.DefaultOptions()
+	expectedOpts.Adjustments = adjustments
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, expectedOpts).
+		Return([]league.Ranking{
+			{Rank: 1, Team: "Lions", Points: 3},
+			{Rank: 2, Team: "Snakes", Points: 0.5, Adjustments: adjustments},
+		})
+	expected := []string{
+		"| Platz | Mannschaft | Pkt. |",
+		"| ---: | --- | ---: |",
+		"| 1 | Lions | 3 |",
+		`| 2 | Snakes\* | 0,5 |`,
+		"",
+		`\* Snakes: -1,5 Pkt. (Abzug)`,
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenLocaleAndRankByGlicko2() {
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateGlicko2Ratings", []league.GameResult{}, league.DefaultGlicko2Options()).
+		Return([]league.Glicko2Rating{{Rank: 1, Team: "Lions", Rating: 1662.31, Deviation: 290.32, Volatility: 0.05999}})
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{RankBy: "glicko2", Locale: "af"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal([]string{"1. Lions, 1662 (RD 290, vol 0,0600)"}, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenLocaleAndHTML() {
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return([]league.Ranking{{Rank: 1, Team: "Lions", Points: 3}})
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{OutputFormat: "html", Locale: "fr"})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Contains(actual, `<html lang="fr">`)
+	suite.Contains(actual, "<title>Classement</title>")
+	suite.Contains(actual, `<tr><th class="numeric">Rang</th><th>Équipe</th><th class="numeric">Pts</th></tr>`)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenLocaleAndTemplateOrdinals() {
+	// Setup fixture and expectations
+	cases := []struct {
+		locale   string
+		expected string
+	}{
+		{"af", "1ste 2de 7de 8ste 19de 20ste 21ste 100ste 102de 0de"},
+		{"de", "1. 2. 7. 8. 19. 20. 21. 100. 102. 0."},
+		{"fr", "1er 2e 7e 8e 19e 20e 21e 100e 102e 0e"},
+	}
+	templateFixture := []string{
+		"{{ordinal 1}} {{ordinal 2}} {{ordinal 7}} {{ordinal 8}} {{ordinal 19}} {{ordinal 20}} " +
+			"{{ordinal 21}} {{ordinal 100}} {{ordinal 102}} {{ordinal 0}}",
+	}
+
+	for i, test := range cases {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Setup expectations
+			suite.mockUsecaseSvc.Mock.
+				On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+				Return([]league.Ranking{})
+
+			// Exercise SUT
+			actual, err := suite.sut.CalculateRankings(nil, adapter.Options{TemplateRows: templateFixture, Locale: test.locale})
+
+			// Verify results
+			suite.NoError(err)
+			suite.Equal([]string{test.expected}, actual)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenUnknownLocale_ShouldFail() {
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{Locale: "nl"})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "nl - "+adapter.ErrUnknownLocale.Error()+", expected one of: en, af, de, fr")
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenRankingStyle_ShouldPassOn() {
+	// Setup fixture and expectations
+	cases := []struct {
+		rankingStyle string
+		expected     league.RankingStyle
+	}{
+		{"", league.RankingStyleStandard},
+		{"standard", league.RankingStyleStandard},
+		{" Dense ", league.RankingStyleDense},
+		{"modified", league.RankingStyleModified},
+		{"ORDINAL", league.RankingStyleOrdinal},
+	}
+
+	for i, test := range cases {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Setup expectations
+			expectedOpts := league.DefaultOptions()
+			expectedOpts.RankingStyle = test.expected
+			suite.mockUsecaseSvc.Mock.
+				On("CalculateRankings", []league.GameResult{}, expectedOpts).
+				Return([]league.Ranking{})
+
+			// Exercise SUT
+			_, err := suite.sut.CalculateRankings(nil, adapter.Options{RankingStyle: test.rankingStyle})
+
+			// Verify results
+			suite.NoError(err)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenRankingStyleAndRankByRatingMethod_ShouldPassOn() {
+	// Setup expectations
+	glicko2Opts := league.DefaultGlicko2Options()
+	glicko2Opts.RankingStyle = league.RankingStyleDense
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateGlicko2Ratings", []league.GameResult{}, glicko2Opts).
+		Return([]league.Glicko2Rating{})
+	bradleyTerryOpts := league.DefaultBradleyTerryOptions()
+	bradleyTerryOpts.RankingStyle = league.RankingStyleDense
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateBradleyTerryRatings", []league.GameResult{}, bradleyTerryOpts).
+		Return(league.BradleyTerryModel{})
+	matrixOpts := league.MatrixOptions{RankingStyle: league.RankingStyleDense}
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateColleyRatings", []league.GameResult{}, matrixOpts).
+		Return([]league.MatrixRating{})
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateMasseyRatings", []league.GameResult{}, matrixOpts).
+		Return([]league.MatrixRating{})
+
+	for _, rankBy := range []string{"glicko2", "bradley-terry", "colley", "massey"} {
+		suite.Run(rankBy, func() {
+			// Exercise SUT
+			_, err := suite.sut.CalculateRankings(nil, adapter.Options{RankBy: rankBy, RankingStyle: "dense"})
+
+			// Verify results
+			suite.NoError(err)
+		})
+	}
+	suite.mockUsecaseSvc.AssertExpectations(suite.T())
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenUnknownRankingStyleAndRankByRatingMethod_ShouldFail() {
+	for _, rankBy := range []string{"glicko2", "bradley-terry", "colley", "massey"} {
+		suite.Run(rankBy, func() {
+			// Exercise SUT
+			actual, err := suite.sut.CalculateRankings(nil, adapter.Options{RankBy: rankBy, RankingStyle: "bogus"})
+
+			// Verify results
+			suite.Nil(actual)
+			suite.ErrorIs(err, adapter.ErrUnknownRankingStyle)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenTieMarker() {
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return([]league.Ranking{
+			{Rank: 1, Team: "Tarantulas", Points: 6},
+			{Rank: 2, Team: "FC Awesome", Points: 1},
+			{Rank: 2, Team: "Snakes", Points: 1},
+			{Rank: 4, Team: "Grouches", Points: 0},
+		})
+	expectedText := []string{
+		"1. Tarantulas, 6 pts",
+		"T2. FC Awesome, 1 pt",
+		"T2. Snakes, 1 pt",
+		"4. Grouches, 0 pts",
+	}
+	expectedMarkdown := []string{
+		"| Rank | Team | Pts |",
+		"| ---: | --- | ---: |",
+		"| 1 | Tarantulas | 6 |",
+		"| =2 | FC Awesome | 1 |",
+		"| =2 | Snakes | 1 |",
+		"| 4 | Grouches | 0 |",
+	}
+
+	// Exercise SUT
+	actualText, errText := suite.sut.CalculateRankings(nil, adapter.Options{TieMarker: "T"})
+	actualMarkdown, errMarkdown := suite.sut.CalculateRankings(nil, adapter.Options{TieMarker: "=", OutputFormat: "markdown"})
+
+	// Verify results
+	suite.NoError(errText)
+	suite.Equal(expectedText, actualText)
+	suite.NoError(errMarkdown)
+	suite.Equal(expectedMarkdown, actualMarkdown)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenTieMarkerAndRankByMatrixMethod() {
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateColleyRatings", []league.GameResult{}, league.DefaultMatrixOptions()).
+		Return([]league.MatrixRating{
+			{Rank: 1, Team: "Lions", Rating: 0.5},
+			{Rank: 1, Team: "Snakes", Rating: 0.5},
+		})
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{RankBy: "colley", TieMarker: "="})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal([]string{"=1. Lions, 0.500", "=1. Snakes, 0.500"}, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenTemplateSharedRanks() {
+	// Setup fixture
+	optsFixture := adapter.Options{TemplateRows: []string{
+		`{{range .Rankings}}{{if .Shared}}T{{end}}{{.Rank}} {{end}}`,
+	}}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return([]league.Ranking{{Rank: 1}, {Rank: 1}, {Rank: 3}})
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal([]string{"T1 T1 3 "}, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenUnknownRankingStyle_ShouldFail() {
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{RankingStyle: "fractional"})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "fractional - "+adapter.ErrUnknownRankingStyle.Error()+
+		", expected one of: standard, dense, modified, ordinal")
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestListTeamGames() {
+	// Setup fixture
+	rowsFixture := []string{
+		"Lions 3, Snakes 1",
+		"Grouches W/O, Lions",
+		"Lions 2, Tarantulas 2",
+		"Lions 1, FC Awesome 0 (abandoned)",
+		"Lions 2, Snakes 3 (SO)",
+	}
+	gameResults := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Grouches", TeamB: "Lions", Status: league.StatusAwardedA},
+		{TeamA: "Lions", ScoreA: 2, TeamB: "Tarantulas", ScoreB: 2},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 0, Status: league.StatusAbandoned},
+		{TeamA: "Lions", ScoreA: 2, TeamB: "Snakes", ScoreB: 3, Decision: league.DecisionShootout},
+	}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("TeamGames", gameResults, "Lions").
+		Return([]league.GameResult{
+			gameResults[0],
+			gameResults[1].Reversed(),
+			gameResults[2],
+			gameResults[3],
+			gameResults[4],
+		})
+	expected := []string{
+		"W 3-1 vs Snakes",
+		"L W/O vs Grouches",
+		"D 2-2 vs Tarantulas",
+		"- abandoned vs FC Awesome",
+		"L 2-3 vs Snakes (SO)",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.ListTeamGames(rowsFixture, adapter.Options{}, " Lions ")
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestListTeamGames_GivenUnknownTeam_ShouldFail() {
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("TeamGames", []league.GameResult{}, "Bears").
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.ListTeamGames(nil, adapter.Options{}, "Bears")
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "Bears - "+adapter.ErrUnknownTeam.Error())
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestSimulateSeasons() {
+	// Setup fixture
+	rowsFixture := []string{"Lions 3, Snakes 0"}
+	optsFixture := adapter.Options{Sport: "chess"}
+	simOptsFixture := adapter.SimulationOptions{
+		FixtureRows: []string{"Round 2", "Snakes, Lions", " Grouches , Lions "},
+		Seed:        7,
+	}
+
+	// Setup expectations
+	preset, _ := league.LookupPreset("chess")
+	expectedOpts := league.DefaultSimulationOptions()
+	expectedOpts.Options = preset.Options
+	expectedOpts.Seed = 7
+	suite.mockUsecaseSvc.Mock.
+		On("SimulateSeasons",
+			[]league.GameResult{{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 0}},
+			[]league.Fixture{{TeamA: "Snakes", TeamB: "Lions"}, {TeamA: "Grouches", TeamB: "Lions"}},
+			expectedOpts).
+		Return([]league.PositionProbabilities{
+			{Team: "Lions", Positions: []float64{0.9, 0.1, 0}},
+			{Team: "Snakes", Positions: []float64{0.1, 0.45, 0.45}},
+			{Team: "Grouches", Positions: []float64{0, 0.45, 0.55}},
+		})
+	expected := []string{
+		"Team, 1, 2, 3",
+		"Lions, 90.0%, 10.0%, 0.0%",
+		"Snakes, 10.0%, 45.0%, 45.0%",
+		"Grouches, 0.0%, 45.0%, 55.0%",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.SimulateSeasons(rowsFixture, optsFixture, simOptsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestSimulateSeasons_GivenSeasons_ShouldPassOn() {
+	// Setup expectations
+	expectedOpts := league.DefaultSimulationOptions()
+	expectedOpts.Seasons = 50
+	expectedOpts.Seed = 0
+	suite.mockUsecaseSvc.Mock.
+		On("SimulateSeasons", []league.GameResult{}, []league.Fixture{}, expectedOpts).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.SimulateSeasons(nil, adapter.Options{}, adapter.SimulationOptions{Seasons: 50})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Nil(actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestSimulateSeasons_InvalidFixtures() {
+	// Setup fixture and expectations
+	cases := []struct {
+		fixture        []string
+		expectedErrMsg string
+	}{
+		{
+			[]string{"Lions"},
+			"could not convert row 1 of fixtures: expected 2 sections after splitting by comma but got 1: " +
+				adapter.ErrMalformedFixture.Error(),
+		},
+		{
+			[]string{"Lions, Snakes", "Lions, Snakes, Grouches"},
+			"could not convert row 2 of fixtures: expected 2 sections after splitting by comma but got 3: " +
+				adapter.ErrMalformedFixture.Error(),
+		},
+		{
+			[]string{" , Snakes"},
+			"could not convert row 1 of fixtures: team name is empty: " + adapter.ErrMalformedFixture.Error(),
+		},
+		{
+			[]string{"Round two"},
+			malformedRowErrMsg("could not convert row 1 of fixtures: round is not a positive integer [two]"),
+		},
+	}
+
+	for i, test := range cases {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Exercise SUT
+			actual, err := suite.sut.SimulateSeasons(nil, adapter.Options{}, adapter.SimulationOptions{FixtureRows: test.fixture})
+
+			// Verify results
+			suite.Nil(actual)
+			suite.EqualError(err, test.expectedErrMsg)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestPredictFixtures() {
+	// Setup fixture
+	rowsFixture := []string{"Lions 2, Snakes 1"}
+	predictOptsFixture := adapter.PredictionOptions{
+		FixtureRows:   []string{"Snakes, Lions", "Lions, Snakes"},
+		HomeAdvantage: true,
+	}
+
+	// Setup expectations
+	expectedOpts := league.DefaultPoissonOptions()
+	expectedOpts.HomeAdvantage = true
+	suite.mockUsecaseSvc.Mock.
+		On("FitPoissonModel",
+			[]league.GameResult{{TeamA: "Lions", ScoreA: 2, TeamB: "Snakes", ScoreB: 1}},
+			expectedOpts).
+		Return(league.PoissonModel{
+			Average:       1,
+			Attack:        map[string]float64{"Lions": 2, "Snakes": 1},
+			Defence:       map[string]float64{"Lions": 1, "Snakes": 0.5},
+			HomeAdvantage: 1.5,
+		})
+	expected := []string{
+		"Snakes vs Lions",
+		"Expected score: 1.50 - 1.00",
+		"Snakes win 48.8%, draw 26.0%, Lions win 25.2%",
+		"Most likely scores: 1-0 (12.3%), 1-1 (12.3%), 2-0 (9.2%), 2-1 (9.2%), 0-0 (8.2%)",
+		"",
+		"Lions vs Snakes",
+		"Expected score: 1.50 - 1.00",
+		"Lions win 48.8%, draw 26.0%, Snakes win 25.2%",
+		"Most likely scores: 1-0 (12.3%), 1-1 (12.3%), 2-0 (9.2%), 2-1 (9.2%), 0-0 (8.2%)",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.PredictFixtures(rowsFixture, adapter.Options{}, predictOptsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestPredictFixtures_GivenUnknownTeam_ShouldFail() {
+	// Setup fixture
+	predictOptsFixture := adapter.PredictionOptions{FixtureRows: []string{"Lions, Snakes", "Lions, Bears"}}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("FitPoissonModel", []league.GameResult{}, league.DefaultPoissonOptions()).
+		Return(league.PoissonModel{
+			Attack:  map[string]float64{"Lions": 1, "Snakes": 1},
+			Defence: map[string]float64{"Lions": 1, "Snakes": 1},
+		})
+
+	// Exercise SUT
+	actual, err := suite.sut.PredictFixtures(nil, adapter.Options{}, predictOptsFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, "could not predict fixture 1: Bears - "+adapter.ErrUnknownTeam.Error())
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestEvaluateWhatIf() {
+	// Setup fixture
+	rowsFixture := []string{"Lions 3, Snakes 0"}
+	whatIfOptsFixture := adapter.WhatIfOptions{HypotheticalRows: []string{"Snakes 2, Lions 0", "Bears 1, Lions 1"}}
+	base := []league.GameResult{{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 0}}
+	before := []league.Ranking{
+		{Rank: 1, Team: "Lions", Points: 3},
+		{Rank: 2, Team: "Snakes", Points: 0},
+	}
+	after := []league.Ranking{
+		{Rank: 1, Team: "Lions", Points: 4},
+		{Rank: 2, Team: "Snakes", Points: 3},
+		{Rank: 3, Team: "Bears", Points: 1},
+	}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", base, league.DefaultOptions()).
+		Return(before)
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", append(base,
+			league.GameResult{TeamA: "Snakes", ScoreA: 2, TeamB: "Lions", ScoreB: 0},
+			league.GameResult{TeamA: "Bears", ScoreA: 1, TeamB: "Lions", ScoreB: 1}),
+			league.DefaultOptions()).
+		Return(after)
+	suite.mockUsecaseSvc.Mock.
+		On("DiffRankings", before, after).
+		Return([]league.RankingChange{
+			{Team: "Lions", Before: &before[0], After: &after[0], PointsDelta: 1},
+			{Team: "Snakes", Before: &before[1], After: &after[1], PointsDelta: 3},
+			{Team: "Bears", After: &after[2]},
+		})
+	expected := []string{
+		"Before           | After",
+		"1. Lions, 3 pts  | 1. Lions, 4 pts (same, +1 pt)",
+		"2. Snakes, 0 pts | 2. Snakes, 3 pts (same, +3 pts)",
+		"                 | 3. Bears, 1 pt (new)",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.EvaluateWhatIf(rowsFixture, adapter.Options{}, whatIfOptsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestEvaluateWhatIf_GivenChangedPositions() {
+	// Setup fixture
+	before := []league.Ranking{
+		{Rank: 1, Team: "Lions", Points: 3},
+		{Rank: 2, Team: "Snakes", Points: 1.5},
+	}
+	after := []league.Ranking{
+		{Rank: 1, Team: "Snakes", Points: 4.5},
+		{Rank: 2, Team: "Lions", Points: 2},
+	}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return(before).Once()
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return(after).Once()
+	suite.mockUsecaseSvc.Mock.
+		On("DiffRankings", before, after).
+		Return([]league.RankingChange{
+			{Team: "Snakes", Before: &before[1], After: &after[0], RankDelta: 1, PointsDelta: 3},
+			{Team: "Lions", Before: &before[0], After: &after[1], RankDelta: -1, PointsDelta: -1},
+		})
+	expected := []string{
+		"Before             | After",
+		"1. Lions, 3 pts    | 1. Snakes, 4.5 pts (up 1, +3 pts)",
+		"2. Snakes, 1.5 pts | 2. Lions, 2 pts (down 1, -1 pt)",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.EvaluateWhatIf(nil, adapter.Options{}, adapter.WhatIfOptions{})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestEvaluateWhatIf_GivenMalformedHypothetical_ShouldFail() {
+	// Exercise SUT
+	actual, err := suite.sut.EvaluateWhatIf(nil, adapter.Options{}, adapter.WhatIfOptions{HypotheticalRows: []string{"Lions 2"}})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, malformedRowErrMsg(
+		"hypothetical results: could not convert row 1 of input: expected 2 sections after splitting by comma but got 1"))
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestEvaluateWhatIf_GivenMalformedHypotheticalWithSources_ShouldFailWithSource() {
+	// Setup fixture
+	whatIfOptsFixture := adapter.WhatIfOptions{
+		HypotheticalRows:    []string{"Lions 2, Snakes 0", "Lions 2"},
+		HypotheticalSources: []string{"hypothetical.txt:1", "hypothetical.txt:3"},
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.EvaluateWhatIf(nil, adapter.Options{}, whatIfOptsFixture)
+
+	// Verify results
+	suite.Nil(actual)
+	suite.EqualError(err, malformedRowErrMsg(
+		"hypothetical results: could not convert row at hypothetical.txt:3: expected 2 sections after splitting by comma but got 1"))
+}
+
+func (suite *RowIOGatewayImplTestSuite) diffRankingsFixture() ([]league.Ranking, []league.Ranking, []league.RankingChange) {
+	before := []league.Ranking{
+		{Rank: 1, Team: "Tarantulas", Points: 6},
+		{Rank: 2, Team: "Lions", Points: 5},
+		{Rank: 3, Team: "Snakes", Points: 1},
+		{Rank: 4, Team: "Grouches", Points: 0},
+	}
+	after := []league.Ranking{
+		{Rank: 1, Team: "Lions", Points: 7},
+		{Rank: 2, Team: "Tarantulas", Points: 6},
+		{Rank: 3, Team: "Snakes", Points: 1},
+		{Rank: 4, Team: "Bears", Points: 1},
+	}
+	changes := []league.RankingChange{
+		{Team: "Lions", Before: &before[1], After: &after[0], RankDelta: 1, PointsDelta: 2},
+		{Team: "Tarantulas", Before: &before[0], After: &after[1], RankDelta: -1},
+		{Team: "Snakes", Before: &before[2], After: &after[2]},
+		{Team: "Bears", After: &after[3]},
+		{Team: "Grouches", Before: &before[3]},
+	}
+	return before, after, changes
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestDiffResults() {
+	// Setup fixture
+	rowsFixture := []string{"Lions 2, Snakes 0"}
+	diffOptsFixture := adapter.DiffOptions{PreviousRows: []string{"Lions 0, Snakes 2"}}
+	before, after, changes := suite.diffRankingsFixture()
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings",
+			[]league.GameResult{{TeamA: "Lions", ScoreA: 0, TeamB: "Snakes", ScoreB: 2}},
+			league.DefaultOptions()).
+		Return(before)
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings",
+			[]league.GameResult{{TeamA: "Lions", ScoreA: 2, TeamB: "Snakes", ScoreB: 0}},
+			league.DefaultOptions()).
+		Return(after)
+	suite.mockUsecaseSvc.Mock.On("DiffRankings", before, after).Return(changes)
+	expected := []string{
+		"Lions: 2. -> 1. (up 1), 5 -> 7 pts (+2 pts)",
+		"Tarantulas: 1. -> 2. (down 1), 6 -> 6 pts (+0 pts)",
+		"Bears: new, 4. 1 pt",
+		"Grouches: removed, was 4. 0 pts",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.DiffResults(rowsFixture, adapter.Options{}, diffOptsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestDiffResults_GivenJSON() {
+	// Setup fixture
+	before, after, changes := suite.diffRankingsFixture()
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return(before).Once()
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return(after).Once()
+	suite.mockUsecaseSvc.Mock.On("DiffRankings", before, after).Return(changes[3:])
+	expected := `[
+  {
+    "team": "Bears",
+    "status": "new",
+    "after": {
+      "rank": 4,
+      "points": 1,
+      "winPercentage": 0
+    },
+    "rankDelta": 0,
+    "pointsDelta": 0
+  },
+  {
+    "team": "Grouches",
+    "status": "removed",
+    "before": {
+      "rank": 4,
+      "points": 0,
+      "winPercentage": 0
+    },
+    "rankDelta": 0,
+    "pointsDelta": 0
+  }
+]`
+
+	// Exercise SUT
+	actual, err := suite.sut.DiffResults(nil, adapter.Options{OutputFormat: " JSON "}, adapter.DiffOptions{})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, strings.Join(actual, "\n"))
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestDiffResults_GivenNoChanges() {
+	// Setup fixture
+	rankingsFixture := []league.Ranking{{Rank: 1, Team: "Lions", Points: 3}}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return(rankingsFixture)
+	suite.mockUsecaseSvc.Mock.
+		On("DiffRankings", rankingsFixture, rankingsFixture).
+		Return([]league.RankingChange{{Team: "Lions", Before: &rankingsFixture[0], After: &rankingsFixture[0]}})
+
+	// Exercise SUT
+	actual, err := suite.sut.DiffResults(nil, adapter.Options{}, adapter.DiffOptions{})
+	actualJSON, errJSON := suite.sut.DiffResults(nil, adapter.Options{OutputFormat: "json"}, adapter.DiffOptions{})
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal([]string{"No changes"}, actual)
+	suite.NoError(errJSON)
+	suite.Equal([]string{"[]"}, actualJSON)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestDiffResults_InvalidInput() {
+	// Setup fixture and expectations
+	cases := []struct {
+		rows           []string
+		opts           adapter.Options
+		diffOpts       adapter.DiffOptions
+		expectedErrMsg string
+	}{
+		{
+			nil,
+			adapter.Options{OutputFormat: "yaml"},
+			adapter.DiffOptions{},
+			"yaml - " + adapter.ErrUnknownOutputFormat.Error() + ", expected one of: text, json",
+		},
+		{
+			[]string{"Lions 2"},
+			adapter.Options{},
+			adapter.DiffOptions{},
+			malformedRowErrMsg("could not convert row 1 of input: expected 2 sections after splitting by comma but got 1"),
+		},
+		{
+			nil,
+			adapter.Options{},
+			adapter.DiffOptions{PreviousRows: []string{"Lions 2"}},
+			malformedRowErrMsg(
+				"previous results: could not convert row 1 of input: expected 2 sections after splitting by comma but got 1"),
+		},
+		{
+			nil,
+			adapter.Options{},
+			adapter.DiffOptions{PreviousRows: []string{"Lions 2"}, PreviousSources: []string{"previous.txt:3"}},
+			malformedRowErrMsg(
+				"previous results: could not convert row at previous.txt:3: expected 2 sections after splitting by comma but got 1"),
+		},
+	}
+
+	for i, test := range cases {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Exercise SUT
+			actual, err := suite.sut.DiffResults(test.rows, test.opts, test.diffOpts)
+
+			// Verify results
+			suite.Nil(actual)
+			suite.EqualError(err, test.expectedErrMsg)
+		})
+	}
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenFixtures_ShouldMarkTeams() {
+	// Setup fixture
+	optsFixture := adapter.Options{
+		FixtureRows:  []string{"Snakes, Grouches", "Lions, Bears"},
+		PlayoffSpots: 2,
+	}
+
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return([]league.Ranking{
+			{Rank: 1, Team: "Lions", Points: 9},
+			{Rank: 2, Team: "Snakes", Points: 6},
+			{Rank: 3, Team: "Grouches", Points: 4},
+			{Rank: 4, Team: "Bears", Points: 0},
+		})
+	suite.mockUsecaseSvc.Mock.
+		On("CalculatePositionRanges",
+			[]league.GameResult{},
+			[]league.Fixture{{TeamA: "Snakes", TeamB: "Grouches"}, {TeamA: "Lions", TeamB: "Bears"}},
+			league.DefaultOptions()).
+		Return(map[string]league.PositionRange{
+			"Lions":    {Best: 1, Worst: 2, Exact: true},
+			"Snakes":   {Best: 2, Worst: 3, Exact: true},
+			"Grouches": {Best: 2, Worst: 3, Exact: true},
+			"Bears":    {Best: 4, Worst: 4, Exact: true},
+		})
+	expected := []string{
+		"1. x-Lions, 9 pts",
+		"2. Snakes, 6 pts",
+		"3. Grouches, 4 pts",
+		"4. e-Bears, 0 pts",
+	}
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+	// Verify results
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenMalformedFixtures_ShouldFail() {
+	// Setup expectations
+	suite.mockUsecaseSvc.Mock.
+		On("CalculateRankings", []league.GameResult{}, league.DefaultOptions()).
+		Return(nil)
+
+	// Exercise SUT
+	actual, err := suite.sut.CalculateRankings(nil, adapter.Options{FixtureRows: []string{"Lions"}})
+
+	// Verify results
+	suite.Nil(actual)
+	suite.ErrorIs(err, adapter.ErrMalformedFixture)
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestCalculateRankings_GivenFixturesAndRankByRatingMethod_ShouldFail() {
+	for _, rankBy := range []string{"glicko2", "bradley-terry", "colley", "massey"} {
+		suite.Run(rankBy, func() {
+			// Setup fixture
+			optsFixture := adapter.Options{RankBy: rankBy, FixtureRows: []string{"Lions, Snakes"}}
+
+			// Exercise SUT
+			actual, err := suite.sut.CalculateRankings(nil, optsFixture)
+
+			// Verify results
+			suite.Nil(actual)
+			suite.EqualError(err, rankBy+" - "+adapter.ErrFixturesNeedPoints.Error())
+		})
+	}
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func malformedRulesErrMsg(start string) string {
+	return fmt.Sprintf("%s: %s", start, adapter.ErrMalformedRules.Error())
+}
+
+func malformedAdjustmentErrMsg(start string) string {
+	return fmt.Sprintf("%s: %s", start, adapter.ErrMalformedAdjustment.Error())
+}
+
+func malformedRowErrMsg(start string) string {
+	return fmt.Sprintf("%s: %s", start, adapter.ErrMalformedRow.Error())
+}
+
+func (suite *RowIOGatewayImplTestSuite) TestValidateInput() {
+	// Setup fixture and expectations
+	cases := []struct {
+		rows           []string
+		opts           adapter.Options
+		expectedErrMsg string
+	}{
+		{
+			[]string{"round 1", "Lions 3, Snakes 3", "Tarantulas W/O, FC Awesome"},
+			adapter.Options{},
+			"",
+		},
+		{
+			[]string{"Lions 3, Snakes 3", "Lions 2"},
+			adapter.Options{},
+			malformedRowErrMsg("could not convert row 2 of input: expected 2 sections after splitting by comma but got 1"),
+		},
+		{
+			[]string{"Lions 30, Snakes 3"},
+			adapter.Options{MaxScore: 10},
+			malformedRowErrMsg("could not convert row 1 of input: first side: score exceeds the maximum of 10 [30]"),
+		},
+		{
+			[]string{"Lions 3, Snakes 3", "Lions 2"},
+			adapter.Options{InputSources: []string{"jan.txt:1", "feb.txt:3"}},
+			malformedRowErrMsg("could not convert row at feb.txt:3: expected 2 sections after splitting by comma but got 1"),
+		},
+		{
+			[]string{"Lions 3, Snakes 3", "Lions 2"},
+			adapter.Options{InputSources: []string{"jan.txt:1"}},
+			malformedRowErrMsg("could not convert row 2 of input: expected 2 sections after splitting by comma but got 1"),
+		},
+	}
+
+	for i, test := range cases {
+		suite.Run(fmt.Sprintf("Test case %d", i), func() {
+			// Exercise SUT
+			err := suite.sut.ValidateInput(test.rows, test.opts)
+
+			// Verify results
+			if test.expectedErrMsg == "" {
+				suite.NoError(err)
+			} else {
+				suite.EqualError(err, test.expectedErrMsg)
+			}
+		})
+	}
 }
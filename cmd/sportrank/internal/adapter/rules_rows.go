@@ -0,0 +1,106 @@
+package adapter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"gopkg.in/yaml.v3"
+)
+
+// rulesDTO is the YAML form of a rules file. Points which are not given
+// keep their default values.
+type rulesDTO struct {
+	Win      *float64           `yaml:"win"`
+	Draw     *float64           `yaml:"draw"`
+	Loss     *float64           `yaml:"loss"`
+	Overtime *decisionPointsDTO `yaml:"overtime"`
+	Shootout *decisionPointsDTO `yaml:"shootout"`
+	Bonuses  []bonusRuleDTO     `yaml:"bonuses"`
+}
+
+type decisionPointsDTO struct {
+	Win  float64 `yaml:"win"`
+	Loss float64 `yaml:"loss"`
+}
+
+type bonusRuleDTO struct {
+	Name      string  `yaml:"name"`
+	Points    float64 `yaml:"points"`
+	Outcome   string  `yaml:"outcome"`
+	MinScore  *int    `yaml:"min_score"`
+	MaxScore  *int    `yaml:"max_score"`
+	MinMargin *int    `yaml:"min_margin"`
+	MaxMargin *int    `yaml:"max_margin"`
+}
+
+var outcomeNames = map[string]league.Outcome{
+	"":     league.OutcomeAny,
+	"any":  league.OutcomeAny,
+	"win":  league.OutcomeWin,
+	"draw": league.OutcomeDraw,
+	"loss": league.OutcomeLoss,
+}
+
+func (riogi *RowIOGatewayImpl) convertRules(rows []string, scoring league.Scoring) (league.Scoring, error) {
+	if len(rows) == 0 {
+		return scoring, nil
+	}
+
+	// Be strict, so that typos in rule names don't silently change the scoring.
+	var dto rulesDTO
+	decoder := yaml.NewDecoder(strings.NewReader(strings.Join(rows, "\n")))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&dto); err != nil {
+		return league.Scoring{}, fmt.Errorf("could not decode rules: %s: %w", err, ErrMalformedRules)
+	}
+
+	if dto.Win != nil {
+		scoring.WinPoints = *dto.Win
+	}
+	if dto.Draw != nil {
+		scoring.DrawPoints = *dto.Draw
+	}
+	if dto.Loss != nil {
+		scoring.LosePoints = *dto.Loss
+	}
+	if dto.Overtime != nil {
+		scoring.Overtime = riogi.convertDecisionPoints(*dto.Overtime)
+	}
+	if dto.Shootout != nil {
+		scoring.Shootout = riogi.convertDecisionPoints(*dto.Shootout)
+	}
+
+	for i, bonusDTO := range dto.Bonuses {
+		bonus, err := riogi.convertBonusRule(bonusDTO)
+		if err != nil {
+			return league.Scoring{}, fmt.Errorf("could not convert bonus %d of rules: %w", i, err)
+		}
+		scoring.Bonuses = append(scoring.Bonuses, bonus)
+	}
+	return scoring, nil
+}
+
+func (riogi *RowIOGatewayImpl) convertDecisionPoints(dto decisionPointsDTO) *league.DecisionPoints {
+	return &league.DecisionPoints{
+		WinPoints:  dto.Win,
+		LosePoints: dto.Loss,
+	}
+}
+
+func (riogi *RowIOGatewayImpl) convertBonusRule(dto bonusRuleDTO) (league.BonusRule, error) {
+	outcome, ok := outcomeNames[strings.ToLower(strings.TrimSpace(dto.Outcome))]
+	if !ok {
+		return league.BonusRule{}, fmt.Errorf("unknown outcome [%s]: %w", dto.Outcome, ErrMalformedRules)
+	}
+
+	return league.BonusRule{
+		Name:      dto.Name,
+		Points:    dto.Points,
+		Outcome:   outcome,
+		MinScore:  dto.MinScore,
+		MaxScore:  dto.MaxScore,
+		MinMargin: dto.MinMargin,
+		MaxMargin: dto.MaxMargin,
+	}, nil
+}
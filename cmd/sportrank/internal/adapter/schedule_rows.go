@@ -0,0 +1,70 @@
+package adapter
+
+import (
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+// Nil unless strength of schedule should be output.
+func (riogi *RowIOGatewayImpl) calculateStrengthOfSchedule(
+	gameResults []league.GameResult,
+	opts Options,
+	teamValues map[string]float64,
+) map[string]league.StrengthOfSchedule {
+	if !opts.StrengthOfSchedule {
+		return nil
+	}
+	return riogi.usecaseSvc.CalculateStrengthOfSchedule(gameResults, teamValues)
+}
+
+// Adds the team's strength of schedule to its output row, unless strength of
+// schedule should not be output. decimals should match the precision of the
+// ranked value.
+func (riogi *RowIOGatewayImpl) addStrengthOfSchedule(
+	row textRow,
+	schedules map[string]league.StrengthOfSchedule,
+	team string,
+	decimals int,
+	loc locale,
+) textRow {
+	if schedules == nil {
+		return row
+	}
+	schedule := schedules[team]
+	row.values[len(row.values)-1] += ","
+	row.values = append(row.values,
+		loc.messages.schedule, loc.formatNumber(schedule.Opponents, decimals),
+		"("+loc.messages.opponentsOpponents, loc.formatNumber(schedule.OpponentsOpponents, decimals)+")")
+	return row
+}
+
+// Columns to add to a table, or none if strength of schedule should not be
+// output.
+func (riogi *RowIOGatewayImpl) strengthOfScheduleColumns(
+	schedules map[string]league.StrengthOfSchedule,
+	loc locale,
+) []tableColumn {
+	if schedules == nil {
+		return nil
+	}
+	return []tableColumn{
+		{header: loc.messages.scheduleHeader, numeric: true},
+		{header: loc.messages.opponentsOpponentsHeader, numeric: true},
+	}
+}
+
+// Cells to add to the team's table row, as for strengthOfScheduleColumns.
+func (riogi *RowIOGatewayImpl) strengthOfScheduleCells(
+	schedules map[string]league.StrengthOfSchedule,
+	team string,
+	decimals int,
+	loc locale,
+) []string {
+	if schedules == nil {
+		return nil
+	}
+	schedule := schedules[team]
+	return []string{
+		loc.formatNumber(schedule.Opponents, decimals),
+		loc.formatNumber(schedule.OpponentsOpponents, decimals),
+	}
+}
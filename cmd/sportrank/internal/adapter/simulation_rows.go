@@ -0,0 +1,97 @@
+package adapter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+func (riogi *RowIOGatewayImpl) SimulateSeasons(rows []string, opts Options, simOpts SimulationOptions) ([]string, error) {
+	gameResults, err := riogi.convertInput(rows, opts.InputSources, opts)
+	if err != nil {
+		return nil, err
+	}
+	fixtures, err := riogi.convertFixtures(simOpts.FixtureRows)
+	if err != nil {
+		return nil, err
+	}
+	leagueOpts, err := riogi.convertOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	simulationOpts := league.DefaultSimulationOptions()
+	simulationOpts.Options = leagueOpts
+	simulationOpts.Seed = simOpts.Seed
+	simulationOpts.Poisson.HomeAdvantage = simOpts.HomeAdvantage
+	if simOpts.Seasons > 0 {
+		simulationOpts.Seasons = simOpts.Seasons
+	}
+
+	probabilities := riogi.usecaseSvc.SimulateSeasons(gameResults, fixtures, simulationOpts)
+
+	return riogi.convertOutputPositionProbabilities(probabilities), nil
+}
+
+func (riogi *RowIOGatewayImpl) convertFixtures(rows []string) ([]league.Fixture, error) {
+	fixtures := make([]league.Fixture, 0, len(rows))
+	round, date := uint(0), time.Time{}
+	for i, row := range rows {
+		// Round and date headers are allowed, as for game rows, but are ignored.
+		isHeader, err := riogi.convertHeaderRow(row, &round, &date)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert row %d of fixtures: %w", i+1, err)
+		}
+		if isHeader {
+			continue
+		}
+
+		fixture, err := riogi.convertFixtureRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert row %d of fixtures: %w", i+1, err)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, nil
+}
+
+func (riogi *RowIOGatewayImpl) convertFixtureRow(row string) (league.Fixture, error) {
+	sides := strings.Split(row, rowSplitStr)
+	if len(sides) != 2 {
+		return league.Fixture{}, fmt.Errorf("expected 2 sections after splitting by comma but got %d: %w",
+			len(sides), ErrMalformedFixture)
+	}
+
+	teamA, teamB := strings.TrimSpace(sides[0]), strings.TrimSpace(sides[1])
+	if teamA == "" || teamB == "" {
+		return league.Fixture{}, fmt.Errorf("team name is empty: %w", ErrMalformedFixture)
+	}
+	return league.Fixture{TeamA: teamA, TeamB: teamB}, nil
+}
+
+func (riogi *RowIOGatewayImpl) convertOutputPositionProbabilities(probabilities []league.PositionProbabilities) []string {
+	if len(probabilities) == 0 {
+		return nil
+	}
+
+	header := make([]string, len(probabilities)+1)
+	header[0] = "Team"
+	for i := 1; i < len(header); i++ {
+		header[i] = strconv.Itoa(i)
+	}
+
+	rows := make([]string, 0, len(probabilities)+1)
+	rows = append(rows, strings.Join(header, rowSplitStr+sideSplitStr))
+	for _, teamProbabilities := range probabilities {
+		cells := make([]string, len(teamProbabilities.Positions)+1)
+		cells[0] = teamProbabilities.Team
+		for i, probability := range teamProbabilities.Positions {
+			cells[i+1] = formatProbability(probability)
+		}
+		rows = append(rows, strings.Join(cells, rowSplitStr+sideSplitStr))
+	}
+	return rows
+}
@@ -0,0 +1,211 @@
+package adapter
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// rankingTable is a table of rankings, which may be rendered in any of the
+// ranking output formats.
+type rankingTable struct {
+	columns []tableColumn
+	// A row of cells per team, one for each column.
+	cells [][]string
+	// A row per team, for text output.
+	textRows []textRow
+	// Notes which follow the table, e.g. adjustments.
+	footnotes []string
+	// Each team's full ranking, for templates. Nil unless ranking by points.
+	rankings []templateRanking
+}
+
+type tableColumn struct {
+	header string
+	// Numeric columns are right aligned.
+	numeric bool
+}
+
+func (rt *rankingTable) addRow(row textRow, cells ...string) {
+	rt.textRows = append(rt.textRows, row)
+	rt.cells = append(rt.cells, cells)
+}
+
+// textRow is a team's row for text output, of the form:
+// "<Rank>. <Team>, <Value> ..."
+// It is kept in parts, so that it may be aligned.
+type textRow struct {
+	rank string
+	team string
+	// The words after the team, e.g. the points and the point suffix. Each is
+	// a column of its own when aligned.
+	values []string
+}
+
+func (tr textRow) String() string {
+	return fmt.Sprintf("%s. %s, %s", tr.rank, tr.team, strings.Join(tr.values, " "))
+}
+
+func (riogi *RowIOGatewayImpl) renderTable(table rankingTable, outputFormat string, align bool, loc locale) []string {
+	switch outputFormat {
+	case outputFormatMarkdown:
+		return riogi.renderMarkdownTable(table)
+	case outputFormatHTML:
+		return riogi.renderHTMLTable(table, loc)
+	default:
+		return riogi.renderTextTable(table, align)
+	}
+}
+
+func (riogi *RowIOGatewayImpl) renderTextTable(table rankingTable, align bool) []string {
+	rows := make([]string, len(table.textRows))
+	if align {
+		rows = riogi.alignTextRows(table.textRows)
+	} else {
+		for i, row := range table.textRows {
+			rows[i] = row.String()
+		}
+	}
+	// Separate the footnotes from the rankings.
+	if len(table.footnotes) > 0 {
+		rows = append(rows, "")
+		rows = append(rows, table.footnotes...)
+	}
+	return rows
+}
+
+// Pads each part of the rows into columns, with numbers right aligned, and a
+// wider gap after the team in place of the comma.
+func (riogi *RowIOGatewayImpl) alignTextRows(textRows []textRow) []string {
+	cells := make([][]string, len(textRows))
+	var widths []int
+	for i, row := range textRows {
+		cells[i] = append([]string{row.rank + ".", row.team}, row.values...)
+		for j, cell := range cells[i] {
+			if j == len(widths) {
+				widths = append(widths, 0)
+			}
+			if width := utf8.RuneCountInString(cell); width > widths[j] {
+				widths[j] = width
+			}
+		}
+	}
+
+	aligned := make([]string, len(textRows))
+	for i := range textRows {
+		padded := make([]string, len(cells[i]))
+		for j, cell := range cells[i] {
+			padding := strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell))
+			if j == 0 || (j > 1 && riogi.isNumeric(cell)) {
+				padded[j] = padding + cell
+			} else {
+				padded[j] = cell + padding
+			}
+		}
+		aligned[i] = strings.TrimRight(padded[0]+" "+padded[1]+"  "+strings.Join(padded[2:], " "), " ")
+	}
+	return aligned
+}
+
+// Values are formatted here, so a number starts with a digit or a sign.
+func (riogi *RowIOGatewayImpl) isNumeric(value string) bool {
+	trimmed := strings.TrimLeft(value, "+-")
+	return trimmed != "" && unicode.IsDigit([]rune(trimmed)[0])
+}
+
+// --- Markdown related ---
+
+// Characters which would otherwise be taken as Markdown, or end a cell.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`",
+	"[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`,
+)
+
+// Renders a GitHub-flavoured Markdown table.
+func (riogi *RowIOGatewayImpl) renderMarkdownTable(table rankingTable) []string {
+	headers := make([]string, len(table.columns))
+	alignments := make([]string, len(table.columns))
+	for i, column := range table.columns {
+		headers[i] = markdownEscaper.Replace(column.header)
+		alignments[i] = "---"
+		if column.numeric {
+			alignments[i] = "---:"
+		}
+	}
+
+	rows := make([]string, 0, len(table.cells)+2)
+	rows = append(rows, riogi.renderMarkdownRow(headers), riogi.renderMarkdownRow(alignments))
+	for _, cells := range table.cells {
+		escaped := make([]string, len(cells))
+		for i, cell := range cells {
+			escaped[i] = markdownEscaper.Replace(cell)
+		}
+		rows = append(rows, riogi.renderMarkdownRow(escaped))
+	}
+
+	if len(table.footnotes) > 0 {
+		rows = append(rows, "")
+		for _, footnote := range table.footnotes {
+			// A trailing backslash is a hard line break.
+			rows = append(rows, markdownEscaper.Replace(footnote)+`\`)
+		}
+		rows[len(rows)-1] = strings.TrimSuffix(rows[len(rows)-1], `\`)
+	}
+	return rows
+}
+
+func (riogi *RowIOGatewayImpl) renderMarkdownRow(cells []string) string {
+	return "| " + strings.Join(cells, " | ") + " |"
+}
+
+// --- HTML related ---
+
+// Renders a standalone HTML page.
+func (riogi *RowIOGatewayImpl) renderHTMLTable(table rankingTable, loc locale) []string {
+	rows := []string{
+		"<!DOCTYPE html>",
+		fmt.Sprintf(`<html lang="%s">`, loc.tag),
+		"<head>",
+		`<meta charset="utf-8">`,
+		fmt.Sprintf("<title>%s</title>", html.EscapeString(loc.messages.title)),
+		"<style>",
+		"table { border-collapse: collapse; }",
+		"th, td { padding: 0.25em 0.75em; text-align: left; }",
+		".numeric { text-align: right; }",
+		"</style>",
+		"</head>",
+		"<body>",
+		"<table>",
+		"<thead>",
+	}
+
+	headers := make([]string, len(table.columns))
+	for i, column := range table.columns {
+		headers[i] = riogi.renderHTMLCell("th", column.header, column.numeric)
+	}
+	rows = append(rows, "<tr>"+strings.Join(headers, "")+"</tr>", "</thead>", "<tbody>")
+
+	for _, cells := range table.cells {
+		rendered := make([]string, len(cells))
+		for i, cell := range cells {
+			rendered[i] = riogi.renderHTMLCell("td", cell, table.columns[i].numeric)
+		}
+		rows = append(rows, "<tr>"+strings.Join(rendered, "")+"</tr>")
+	}
+	rows = append(rows, "</tbody>", "</table>")
+
+	for _, footnote := range table.footnotes {
+		rows = append(rows, fmt.Sprintf("<p>%s</p>", html.EscapeString(footnote)))
+	}
+	return append(rows, "</body>", "</html>")
+}
+
+func (riogi *RowIOGatewayImpl) renderHTMLCell(tag string, content string, numeric bool) string {
+	class := ""
+	if numeric {
+		class = ` class="numeric"`
+	}
+	return fmt.Sprintf("<%s%s>%s</%s>", tag, class, html.EscapeString(content), tag)
+}
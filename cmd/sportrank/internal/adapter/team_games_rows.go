@@ -0,0 +1,64 @@
+package adapter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+const (
+	outcomeWinLetter  = "W"
+	outcomeDrawLetter = "D"
+	outcomeLossLetter = "L"
+)
+
+func (riogi *RowIOGatewayImpl) ListTeamGames(rows []string, opts Options, team string) ([]string, error) {
+	gameResults, err := riogi.convertInput(rows, opts.InputSources, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	team = strings.TrimSpace(team)
+	games := riogi.usecaseSvc.TeamGames(gameResults, team)
+	if len(games) == 0 {
+		return nil, fmt.Errorf("%s - %w", team, ErrUnknownTeam)
+	}
+
+	outputRows := make([]string, len(games))
+	for i, game := range games {
+		outputRows[i] = riogi.convertOutputTeamGame(game)
+	}
+	return outputRows, nil
+}
+
+// The game is from the point of view of team A.
+func (riogi *RowIOGatewayImpl) convertOutputTeamGame(game league.GameResult) string {
+	outcome, _ := game.Outcomes()
+	switch game.Status {
+	case league.StatusAbandoned:
+		return fmt.Sprintf("- %s vs %s", abandonedMarker, game.TeamB)
+	case league.StatusAwardedA, league.StatusAwardedB:
+		return fmt.Sprintf("%s %s vs %s", riogi.convertOutcomeLetter(outcome), walkoverMarker, game.TeamB)
+	}
+
+	row := fmt.Sprintf("%s %d-%d vs %s", riogi.convertOutcomeLetter(outcome), game.ScoreA, game.ScoreB, game.TeamB)
+	switch game.Decision {
+	case league.DecisionOvertime:
+		row += " " + annotationOpen + strings.ToUpper(overtimeMarker) + annotationClose
+	case league.DecisionShootout:
+		row += " " + annotationOpen + strings.ToUpper(shootoutMarker) + annotationClose
+	}
+	return row
+}
+
+func (riogi *RowIOGatewayImpl) convertOutcomeLetter(outcome league.Outcome) string {
+	switch outcome {
+	case league.OutcomeWin:
+		return outcomeWinLetter
+	case league.OutcomeDraw:
+		return outcomeDrawLetter
+	default:
+		return outcomeLossLetter
+	}
+}
@@ -0,0 +1,143 @@
+package adapter
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+// templateData is what output templates are executed with.
+type templateData struct {
+	// The headers and cells of the rankings table, as for Markdown and HTML
+	// output. Available for every ranking method.
+	Columns []string
+	Rows    [][]string
+	// Each team's points and record. Nil unless ranking by points.
+	Rankings []templateRanking
+	// Notes on adjustments, if they are annotated.
+	Footnotes []string
+}
+
+// templateRanking is a team's ranking, with any stats which were asked for.
+type templateRanking struct {
+	league.Ranking
+	// "x-" if the team has clinched a playoff spot, "e-" if it has been
+	// eliminated from one, or empty.
+	Marker string
+	// Whether another team has the same rank.
+	Shared bool
+	// Nil unless strength of schedule was asked for.
+	Schedule *league.StrengthOfSchedule
+}
+
+func (riogi *RowIOGatewayImpl) convertTemplateRanking(
+	ranking league.Ranking,
+	schedules map[string]league.StrengthOfSchedule,
+	ranges map[string]league.PositionRange,
+	shared map[uint]bool,
+	opts Options,
+) templateRanking {
+	converted := templateRanking{
+		Ranking: ranking,
+		Marker:  riogi.determinePositionMarker(ranges, ranking.Team, opts),
+		Shared:  shared[ranking.Rank],
+	}
+	if schedule, ok := schedules[ranking.Team]; ok {
+		converted.Schedule = &schedule
+	}
+	return converted
+}
+
+func (riogi *RowIOGatewayImpl) renderTemplate(table rankingTable, templateRows []string, loc locale) ([]string, error) {
+	tmpl, err := template.New("output").
+		Funcs(riogi.templateFuncs(loc)).
+		Parse(strings.Join(templateRows, "\n"))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse template: %s: %w", err, ErrMalformedTemplate)
+	}
+
+	data := templateData{
+		Columns:   make([]string, len(table.columns)),
+		Rows:      table.cells,
+		Rankings:  table.rankings,
+		Footnotes: table.footnotes,
+	}
+	for i, column := range table.columns {
+		data.Columns[i] = column.header
+	}
+
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return nil, fmt.Errorf("could not execute template: %s: %w", err, ErrMalformedTemplate)
+	}
+
+	// Each row is written with its own line ending.
+	output := strings.TrimSuffix(rendered.String(), "\n")
+	if output == "" {
+		return nil, nil
+	}
+	return strings.Split(output, "\n"), nil
+}
+
+// Plurals, ordinals and points follow the locale.
+func (riogi *RowIOGatewayImpl) templateFuncs(loc locale) template.FuncMap {
+	return template.FuncMap{
+		// e.g. {{pluralize .Points "pt" "pts"}}
+		"pluralize": func(count interface{}, singular string, plural string) (string, error) {
+			number, err := riogi.convertTemplateNumber(count)
+			if err != nil {
+				return "", err
+			}
+			return loc.pluralize(number, singular, plural), nil
+		},
+		// e.g. {{ordinal .Rank}} gives 1st, 2nd, 3rd, 4th...
+		"ordinal": func(n interface{}) (string, error) {
+			number, err := riogi.convertTemplateNumber(n)
+			if err != nil {
+				return "", err
+			}
+			return loc.ordinal(int64(number)), nil
+		},
+		// Pad to a width in characters, e.g. {{padRight 12 .Team}}.
+		"padLeft": func(width int, value interface{}) string {
+			text := fmt.Sprint(value)
+			return strings.Repeat(" ", riogi.paddingWidth(width, text)) + text
+		},
+		"padRight": func(width int, value interface{}) string {
+			text := fmt.Sprint(value)
+			return text + strings.Repeat(" ", riogi.paddingWidth(width, text))
+		},
+		// Points as in the default output, e.g. 1.5 but not 3.0.
+		"points": func(points float64) string {
+			return loc.formatPoints(points)
+		},
+	}
+}
+
+func (riogi *RowIOGatewayImpl) convertTemplateNumber(value interface{}) (float64, error) {
+	switch number := value.(type) {
+	case int:
+		return float64(number), nil
+	case int64:
+		return float64(number), nil
+	case uint:
+		return float64(number), nil
+	case uint64:
+		return float64(number), nil
+	case float64:
+		return number, nil
+	default:
+		return 0, fmt.Errorf("expected a number but got %T", value)
+	}
+}
+
+func (riogi *RowIOGatewayImpl) paddingWidth(width int, text string) int {
+	padding := width - utf8.RuneCountInString(text)
+	if padding < 0 {
+		return 0
+	}
+	return padding
+}
@@ -0,0 +1,68 @@
+package adapter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+// Ranking styles which may be given in Options.
+const (
+	rankingStyleStandard = "standard"
+	rankingStyleDense    = "dense"
+	rankingStyleModified = "modified"
+	rankingStyleOrdinal  = "ordinal"
+)
+
+var rankingStyles = map[string]league.RankingStyle{
+	rankingStyleStandard: league.RankingStyleStandard,
+	rankingStyleDense:    league.RankingStyleDense,
+	rankingStyleModified: league.RankingStyleModified,
+	rankingStyleOrdinal:  league.RankingStyleOrdinal,
+}
+
+// The names of ranking styles which may be given in Options.
+func RankingStyleNames() []string {
+	return []string{rankingStyleStandard, rankingStyleDense, rankingStyleModified, rankingStyleOrdinal}
+}
+
+func (riogi *RowIOGatewayImpl) convertRankingStyle(rankingStyle string) (league.RankingStyle, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(rankingStyle))
+	if cleaned == "" {
+		return league.RankingStyleStandard, nil
+	}
+
+	style, ok := rankingStyles[cleaned]
+	if !ok {
+		return 0, fmt.Errorf("%s - %w, expected one of: %s",
+			rankingStyle, ErrUnknownRankingStyle, strings.Join(RankingStyleNames(), ", "))
+	}
+	return style, nil
+}
+
+// Ranks held by more than one team, given the rank of each of count teams.
+func (riogi *RowIOGatewayImpl) determineSharedRanks(count int, rank func(i int) uint) map[uint]bool {
+	teamsPerRank := make(map[uint]int, count)
+	for i := 0; i < count; i++ {
+		teamsPerRank[rank(i)]++
+	}
+
+	shared := make(map[uint]bool)
+	for r, teams := range teamsPerRank {
+		if teams > 1 {
+			shared[r] = true
+		}
+	}
+	return shared
+}
+
+// The rank, prefixed with the tie marker in opts if it is shared, e.g. T3.
+func (riogi *RowIOGatewayImpl) formatRank(rank uint, shared map[uint]bool, opts Options) string {
+	formatted := strconv.FormatUint(uint64(rank), 10)
+	if shared[rank] {
+		return opts.TieMarker + formatted
+	}
+	return formatted
+}
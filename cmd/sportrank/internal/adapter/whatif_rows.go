@@ -0,0 +1,85 @@
+package adapter
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+)
+
+const (
+	whatIfBeforeHeader = "Before"
+	whatIfAfterHeader  = "After"
+	whatIfColumnSplit  = " | "
+)
+
+func (riogi *RowIOGatewayImpl) EvaluateWhatIf(rows []string, opts Options, whatIfOpts WhatIfOptions) ([]string, error) {
+	base, err := riogi.convertInput(rows, opts.InputSources, opts)
+	if err != nil {
+		return nil, err
+	}
+	hypothetical, err := riogi.convertInput(whatIfOpts.HypotheticalRows, whatIfOpts.HypotheticalSources, opts)
+	if err != nil {
+		return nil, fmt.Errorf("hypothetical results: %w", err)
+	}
+	leagueOpts, err := riogi.convertOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	before := riogi.usecaseSvc.CalculateRankings(base, leagueOpts)
+	after := riogi.usecaseSvc.CalculateRankings(append(append([]league.GameResult{}, base...), hypothetical...), leagueOpts)
+	changes := riogi.usecaseSvc.DiffRankings(before, after)
+
+	return riogi.convertOutputWhatIf(before, changes, opts, leagueOpts.Metric, defaultLocale), nil
+}
+
+// The tables are side by side, with each team's change in the after column.
+func (riogi *RowIOGatewayImpl) convertOutputWhatIf(
+	before []league.Ranking,
+	changes []league.RankingChange,
+	opts Options,
+	metric league.Metric,
+	loc locale,
+) []string {
+	left := make([]string, 0, len(before)+1)
+	left = append(left, whatIfBeforeHeader)
+	for _, ranking := range before {
+		left = append(left, riogi.convertOutputRanking(ranking, nil, nil, opts, metric, loc))
+	}
+
+	// Hypothetical games only add to the table, so no team is removed.
+	right := make([]string, 0, len(changes)+1)
+	right = append(right, whatIfAfterHeader)
+	for _, change := range changes {
+		if change.Removed() {
+			continue
+		}
+		description := changeStatusNew
+		if !change.New() {
+			description = riogi.describeMove(change) + ", " + riogi.describeValueChange(change, metric, loc)
+		}
+		right = append(right, fmt.Sprintf("%s (%s)",
+			riogi.convertOutputRanking(*change.After, nil, nil, opts, metric, loc), description))
+	}
+
+	width := 0
+	for _, cell := range left {
+		if cellWidth := utf8.RuneCountInString(cell); cellWidth > width {
+			width = cellWidth
+		}
+	}
+
+	// New teams in the hypothetical results make the after column longer.
+	outputRows := make([]string, len(right))
+	for i := range right {
+		cell := ""
+		if i < len(left) {
+			cell = left[i]
+		}
+		padding := strings.Repeat(" ", width-utf8.RuneCountInString(cell))
+		outputRows[i] = cell + padding + whatIfColumnSplit + right[i]
+	}
+	return outputRows
+}
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/adapter"
+)
+
+// The diff command shows how the table changed between two sets of results,
+// e.g. before and after a result was corrected.
+func (ei *EngineImpl) diffCommand() command {
+	return command{
+		name: "diff",
+		defineFlags: func(flagSet *flag.FlagSet, rowOpts *adapter.Options, files *fileArgs) {
+			flagSet.StringVar(&rowOpts.OutputFormat, "output-format", adapter.DiffOutputFormatNames()[0],
+				fmt.Sprintf("How to format the output, one of: %s.", strings.Join(adapter.DiffOutputFormatNames(), ", ")))
+		},
+		compareArgs: true,
+		execute: func(inputRows []string, rowOpts adapter.Options, rows fileRows) ([]string, error) {
+			return ei.rowIOGateway.DiffResults(inputRows, rowOpts, adapter.DiffOptions{
+				PreviousRows:    rows.Previous,
+				PreviousSources: rows.PreviousSources,
+			})
+		},
+	}
+}
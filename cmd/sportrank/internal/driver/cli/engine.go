@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/adapter"
 )
@@ -42,8 +45,87 @@ func NewEngineImpl(rowIOGateway adapter.RowIOGateway) *EngineImpl {
 }
 
 func (ei *EngineImpl) Run(args []string, stdin io.Reader, stdout io.Writer) int {
+	// Subcommands parse the remaining args as if they were the program.
+	if len(args) > 1 {
+		for _, cmd := range ei.subcommands() {
+			if args[1] == cmd.name {
+				return ei.run(cmd, args[1:], stdin, stdout)
+			}
+		}
+	}
+	return ei.run(ei.rankCommand(), args, stdin, stdout)
+}
+
+// command is a mode of operation of the CLI, e.g. ranking or simulating.
+type command struct {
+	name string
+	// Define flags particular to the command.
+	defineFlags func(flagSet *flag.FlagSet, rowOpts *adapter.Options, files *fileArgs)
+	// Positional args are fixture rows, in addition to any fixtures file.
+	fixtureArgs bool
+	// Positional args are the previous and current input files, in that order.
+	compareArgs bool
+	// Text output may be aligned and coloured, see alignRows and prettifyRows.
+	prettyOutput bool
+	// The command may be re-run whenever its input files change, see watch.
+	watchable bool
+	// Execute the business logic.
+	execute func(inputRows []string, rowOpts adapter.Options, rows fileRows) ([]string, error)
+	// Interactive commands take over STDIN and STDOUT instead of executing once.
+	// Input is optional, and may not be STDIN.
+	interact func(inputRows []string, rowOpts adapter.Options, stdin io.Reader, stdout io.Writer) error
+}
+
+func (ei *EngineImpl) subcommands() []command {
+	return []command{
+		ei.simulateCommand(),
+		ei.predictCommand(),
+		ei.whatIfCommand(),
+		ei.diffCommand(),
+		ei.tuiCommand(),
+		ei.replCommand(),
+	}
+}
+
+func (ei *EngineImpl) rankCommand() command {
+	return command{
+		name: "sportrank",
+		defineFlags: func(flagSet *flag.FlagSet, rowOpts *adapter.Options, files *fileArgs) {
+			flagSet.BoolVar(&rowOpts.AnnotateAdjustments, "annotate-adjustments", rowOpts.AnnotateAdjustments,
+				"Mark adjusted teams with an asterisk, and list the reasons below the rankings.")
+			flagSet.StringVar(&rowOpts.RankBy, "rank-by", adapter.RankByNames()[0],
+				fmt.Sprintf("How to rank teams, one of: %s.", strings.Join(adapter.RankByNames(), ", ")))
+			flagSet.DurationVar(&rowOpts.RatingPeriod, "rating-period", rowOpts.RatingPeriod,
+				"Group games into rating periods of this length by date (e.g. 168h), or 0 to group by round.")
+			flagSet.BoolVar(&rowOpts.StrengthOfSchedule, "strength-of-schedule", rowOpts.StrengthOfSchedule,
+				"Show the average of each team's opponents, and of their opponents' opponents.")
+			flagSet.StringVar(&files.Fixtures, "fixtures", "",
+				"Optional file of remaining fixtures, or - for STDIN. Marks teams which have clinched (x) or been eliminated from (e) a playoff spot, when ranking by points.")
+			flagSet.UintVar(&rowOpts.PlayoffSpots, "playoff-spots", 1,
+				"The number of playoff spots, for --fixtures.")
+			flagSet.StringVar(&rowOpts.OutputFormat, "output-format", adapter.RankingOutputFormatNames()[0],
+				fmt.Sprintf("How to format the output, one of: %s.", strings.Join(adapter.RankingOutputFormatNames(), ", ")))
+			flagSet.StringVar(&files.Template, "template", "",
+				"Optional Go text/template file to render the rankings with, instead of --output-format.")
+			flagSet.StringVar(&rowOpts.Locale, "locale", adapter.LocaleNames()[0],
+				fmt.Sprintf("The language of the rankings, one of: %s.", strings.Join(adapter.LocaleNames(), ", ")))
+			flagSet.StringVar(&rowOpts.RankingStyle, "ranking-style", adapter.RankingStyleNames()[0],
+				fmt.Sprintf("How teams level on points are ranked, one of: %s.", strings.Join(adapter.RankingStyleNames(), ", ")))
+			flagSet.StringVar(&rowOpts.TieMarker, "tie-marker", "",
+				"Optional prefix for ranks shared by more than one team, e.g. T or =.")
+		},
+		prettyOutput: true,
+		watchable:    true,
+		execute: func(inputRows []string, rowOpts adapter.Options, rows fileRows) ([]string, error) {
+			rowOpts.FixtureRows = rows.Fixtures
+			return ei.rowIOGateway.CalculateRankings(inputRows, rowOpts)
+		},
+	}
+}
+
+func (ei *EngineImpl) run(cmd command, args []string, stdin io.Reader, stdout io.Writer) int {
 	// Convert args to options.
-	opts, err := ei.evaluateArgs(args, stdin, stdout)
+	opts, err := ei.evaluateArgs(cmd, args, stdin, stdout)
 	if err != nil {
 		if errors.Is(err, errArgParse) {
 			return FlagParseErrorCode
@@ -53,40 +135,114 @@ func (ei *EngineImpl) Run(args []string, stdin io.Reader, stdout io.Writer) int
 	}
 
 	// Make sure we close anything that needs it.
-	if closable, ok := opts.Input.(io.Closer); ok {
+	for _, closable := range opts.Closers {
 		defer closable.Close()
 	}
-	if closable, ok := opts.Output.(io.Closer); ok {
-		defer closable.Close()
+
+	if opts.Watch.Enabled {
+		return ei.watch(cmd, opts, stdin)
+	}
+	if err := ei.executeToOutput(cmd, opts, stdin); err != nil {
+		return ei.fail(err)
+	}
+	return SuccessCode
+}
+
+// Execute the command. An output file is replaced whole once the command is
+// done, unless appending, so that it is never left half written.
+func (ei *EngineImpl) executeToOutput(cmd command, opts options, stdin io.Reader) error {
+	if opts.Output != nil {
+		return ei.execute(cmd, opts, stdin)
 	}
 
-	// Read input
-	inputRows, err := ei.readLines(opts.Input)
+	// The temporary file is created first, so that an output which cannot be
+	// written fails before any input is read.
+	temp, path, err := ei.createTempOutput(strings.TrimSpace(opts.Files.Output))
 	if err != nil {
-		return ei.fail(err)
+		return err
+	}
+	opts.Output = temp
+	return ei.replaceWithTempOutput(temp, path, ei.execute(cmd, opts, stdin))
+}
+
+// Read the inputs in opts, and execute the command, writing to the output.
+func (ei *EngineImpl) execute(cmd command, opts options, stdin io.Reader) error {
+	// Read input, which interactive commands may start without.
+	inputRows, inputSources, err := ei.readNamedInputs(opts.Inputs)
+	if err != nil {
+		return err
+	}
+	opts.RowOptions.InputSources = inputSources
+	if opts.RowOptions.AdjustmentRows, err = ei.readOptionalLines(opts.Adjustments); err != nil {
+		return err
+	}
+	if opts.RowOptions.RulesRows, err = ei.readOptionalLines(opts.Rules); err != nil {
+		return err
+	}
+	var rows fileRows
+	if rows.Fixtures, err = ei.readOptionalLines(opts.Fixtures); err != nil {
+		return err
+	}
+	rows.Fixtures = append(rows.Fixtures, opts.FixtureArgs...)
+	rows.Hypothetical, rows.HypotheticalSources, err = ei.readOptionalNamedInput(opts.Files.Hypothetical, opts.Hypothetical)
+	if err != nil {
+		return err
+	}
+	rows.Previous, rows.PreviousSources, err = ei.readOptionalNamedInput(opts.Files.Previous, opts.Previous)
+	if err != nil {
+		return err
+	}
+	if opts.RowOptions.TemplateRows, err = ei.readOptionalRawLines(opts.Template); err != nil {
+		return err
+	}
+
+	if cmd.interact != nil {
+		return cmd.interact(inputRows, opts.RowOptions, stdin, opts.Output)
+	}
+	if cmd.prettyOutput {
+		opts.RowOptions.AlignText = ei.alignRows(opts.Pretty, opts.Output)
 	}
 
 	// Execute the business logic
-	outputRows, err := ei.rowIOGateway.CalculateRankings(inputRows)
+	outputRows, err := cmd.execute(inputRows, opts.RowOptions, rows)
 	if err != nil {
-		return ei.fail(err)
+		return err
 	}
 
-	// Write output
-	if err := ei.writeLines(opts.Output, outputRows); err != nil {
-		return ei.fail(err)
+	// Templates and other formats are written as given.
+	if cmd.prettyOutput && ei.isTextOutput(opts.RowOptions) {
+		outputRows = ei.prettifyRows(outputRows, opts.Pretty, opts.Output)
 	}
 
-	return SuccessCode
+	// Write output
+	return ei.writeLines(opts.Output, outputRows)
+}
+
+func (ei *EngineImpl) isTextOutput(rowOpts adapter.Options) bool {
+	outputFormat := strings.TrimSpace(rowOpts.OutputFormat)
+	return len(rowOpts.TemplateRows) == 0 &&
+		(outputFormat == "" || strings.EqualFold(outputFormat, adapter.RankingOutputFormatNames()[0]))
 }
 
 func (ei *EngineImpl) readLines(input io.Reader) ([]string, error) {
+	return ei.scanLines(input, false)
+}
+
+// Raw lines include blank lines, e.g. for templates where layout matters.
+func (ei *EngineImpl) readOptionalRawLines(input io.Reader) ([]string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	return ei.scanLines(input, true)
+}
+
+func (ei *EngineImpl) scanLines(input io.Reader, keepBlank bool) ([]string, error) {
 	var lines []string
 	scanner := bufio.NewScanner(input)
 	for scanner.Scan() {
 		line := scanner.Text()
-		// Only include if not blank
-		if strings.TrimSpace(line) != "" {
+		// Only include if not blank, unless asked to
+		if keepBlank || strings.TrimSpace(line) != "" {
 			lines = append(lines, line)
 		}
 	}
@@ -97,6 +253,13 @@ func (ei *EngineImpl) readLines(input io.Reader) ([]string, error) {
 	return lines, nil
 }
 
+func (ei *EngineImpl) readOptionalLines(input io.Reader) ([]string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	return ei.readLines(input)
+}
+
 func (ei *EngineImpl) writeLines(output io.Writer, lines []string) error {
 	// Use a buffered writer for predictable performance
 	bufOutput := bufio.NewWriter(output)
@@ -111,6 +274,73 @@ func (ei *EngineImpl) writeLines(output io.Writer, lines []string) error {
 	return nil
 }
 
+// Replace the file whole, see createTempOutput.
+func (ei *EngineImpl) writeFileAtomically(path string, content []byte) error {
+	temp, path, err := ei.createTempOutput(path)
+	if err != nil {
+		return err
+	}
+	if _, err = temp.Write(content); err != nil {
+		err = fmt.Errorf("could not write to output: %w", err)
+	}
+	return ei.replaceWithTempOutput(temp, path, err)
+}
+
+// New output files are readable by all, less the umask.
+const outputPerm = 0644
+
+// Output is written to a temporary file alongside the output file, which is
+// renamed over it once done, so that readers never see a partial write. The
+// path to rename over is returned too, which is the file a symlink points to,
+// so that the symlink is kept.
+func (ei *EngineImpl) createTempOutput(path string) (*os.File, string, error) {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+
+	// Unlike os.CreateTemp, the umask applies, as for any new file.
+	var temp *os.File
+	var err error
+	for attempt := 0; attempt < 100; attempt++ {
+		name := filepath.Join(filepath.Dir(path),
+			fmt.Sprintf(".%s.%s.tmp", filepath.Base(path), strconv.FormatInt(time.Now().UnixNano()+int64(attempt), 36)))
+		temp, err = os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, outputPerm)
+		if !os.IsExist(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("%s - %w", path, errCouldNotOpenOutput)
+	}
+
+	// A file which is replaced keeps its mode.
+	if info, err := os.Stat(path); err == nil {
+		if err := temp.Chmod(info.Mode().Perm()); err != nil {
+			temp.Close()
+			os.Remove(temp.Name())
+			return nil, "", fmt.Errorf("%s - %w", path, errCouldNotOpenOutput)
+		}
+	}
+	return temp, path, nil
+}
+
+// Rename the temporary file over the output file, unless writing to it failed
+// with err, in which case it is removed and the output file left as it was.
+func (ei *EngineImpl) replaceWithTempOutput(temp *os.File, path string, err error) error {
+	if closeErr := temp.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("could not write to output: %w", closeErr)
+	}
+	if err == nil {
+		if renameErr := os.Rename(temp.Name(), path); renameErr != nil {
+			err = fmt.Errorf("could not write to output: %w", renameErr)
+		}
+	}
+	if err != nil {
+		os.Remove(temp.Name())
+	}
+	return err
+}
+
 func (ei *EngineImpl) fail(err error) int {
 	fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
 	return ei.chooseExitCode(err)
@@ -123,7 +353,20 @@ func (ei *EngineImpl) chooseExitCode(err error) int {
 	if errors.Is(err, errCouldNotOpenOutput) {
 		return CouldNotWriteOutputCode
 	}
-	if errors.Is(err, adapter.ErrMalformedRow) {
+	if errors.Is(err, adapter.ErrUnknownSport) ||
+		errors.Is(err, adapter.ErrUnknownRankBy) ||
+		errors.Is(err, adapter.ErrUnknownOutputFormat) ||
+		errors.Is(err, adapter.ErrUnknownLocale) ||
+		errors.Is(err, adapter.ErrUnknownRankingStyle) ||
+		errors.Is(err, adapter.ErrFixturesNeedPoints) {
+		return FlagParseErrorCode
+	}
+	if errors.Is(err, adapter.ErrMalformedRow) ||
+		errors.Is(err, adapter.ErrMalformedAdjustment) ||
+		errors.Is(err, adapter.ErrMalformedRules) ||
+		errors.Is(err, adapter.ErrMalformedFixture) ||
+		errors.Is(err, adapter.ErrUnknownTeam) ||
+		errors.Is(err, adapter.ErrMalformedTemplate) {
 		return InvalidFormatCode
 	}
 	return InternalErrorCode
@@ -139,35 +382,229 @@ var (
 )
 
 type options struct {
-	Input  io.Reader
-	Output io.Writer
+	// Each input, in the order given. Interactive commands may have none.
+	Inputs []namedInput
+	// Nil when writing to a file which is replaced once done.
+	Output      io.Writer
+	Adjustments io.Reader
+	Rules       io.Reader
+	Fixtures    io.Reader
+	FixtureArgs []string
+	// Hypothetical results, for what-if scenarios.
+	Hypothetical io.Reader
+	// Previous results, for diffs.
+	Previous io.Reader
+	// An output template, when ranking.
+	Template   io.Reader
+	RowOptions adapter.Options
+	Pretty     prettyArgs
+	Watch      watchArgs
+	// The args the above were opened from, e.g. to reopen them when watching.
+	Files fileArgs
+	// Files opened for the above, but not STDIN or STDOUT, which belong to the caller.
+	Closers []io.Closer
+}
+
+// fileArgs are the args of files, where - is STDIN or STDOUT. Empty means an
+// optional file was not given.
+type fileArgs struct {
+	// Inputs may be repeated, and may be glob patterns.
+	Inputs       []string
+	Output       string
+	Adjustments  string
+	Rules        string
+	Fixtures     string
+	Hypothetical string
+	Previous     string
+	Template     string
 }
 
-func (ei *EngineImpl) evaluateArgs(args []string, stdin io.Reader, stdout io.Writer) (options, error) {
+// fileRows are the rows of the files particular to some commands, which
+// commands pass on to the gateway in their own options.
+type fileRows struct {
+	Fixtures     []string
+	Hypothetical []string
+	Previous     []string
+	// Where each hypothetical and previous row came from, see
+	// adapter.Options.InputSources.
+	HypotheticalSources []string
+	PreviousSources     []string
+}
+
+func (ei *EngineImpl) evaluateArgs(cmd command, args []string, stdin io.Reader, stdout io.Writer) (options, error) {
 	// Define and run the flag set.
-	flagSet := flag.NewFlagSet("sportrank", flag.ContinueOnError)
-	inputPtr := flagSet.String("i", "-", "Input file, or - for STDIN.")
-	outputPtr := flagSet.String("o", "-", "Output file, or - for STDOUT.")
+	rowOpts := adapter.DefaultOptions()
+	var files fileArgs
+	flagSet := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	inputUsage := "Input file, or - for STDIN."
+	files.Inputs = []string{"-"}
+	if cmd.interact != nil {
+		inputUsage, files.Inputs = "Optional input file.", nil
+	}
+	flagSet.Var(&inputsFlag{args: &files.Inputs}, "i",
+		inputUsage+" May be repeated, or a glob pattern, e.g. 'results/*.txt', to concatenate files.")
+	flagSet.StringVar(&files.Output, "o", "-", "Output file, or - for STDOUT.")
+	appendOutput := flagSet.Bool("append", false, "Append to the output file, rather than replacing it.")
+	flagSet.BoolVar(&rowOpts.AllowNegativeScores, "allow-negative-scores", rowOpts.AllowNegativeScores,
+		"Accept scores below zero.")
+	flagSet.Func("max-score",
+		"Reject scores above this value, or 0 for no limit. (default 0)",
+		ei.nonNegativeIntFlag(&rowOpts.MaxScore))
+	flagSet.StringVar(&rowOpts.Sport, "sport", rowOpts.Sport,
+		fmt.Sprintf("Built-in scoring system, one of: %s.", strings.Join(adapter.SportNames(), ", ")))
+	flagSet.Func("forfeit-win-points",
+		"Points for a team awarded a game by walkover. (default the points for a win)",
+		ei.optionalFloatFlag(&rowOpts.ForfeitWinPoints))
+	flagSet.Func("forfeit-lose-points",
+		"Points for a team which forfeits a game. (default the points for a loss)",
+		ei.optionalFloatFlag(&rowOpts.ForfeitLosePoints))
+	flagSet.StringVar(&files.Adjustments, "adjustments", "", "Optional file of point adjustments, or - for STDIN.")
+	flagSet.StringVar(&files.Rules, "rules", "", "Optional YAML file of points and bonus rules, or - for STDIN.")
+	cmd.defineFlags(flagSet, &rowOpts, &files)
+	var pretty prettyArgs
+	if cmd.prettyOutput {
+		ei.definePrettyFlags(flagSet, &pretty)
+	}
+	var watch watchArgs
+	if cmd.watchable {
+		ei.defineWatchFlags(flagSet, &watch)
+	}
 	if err := flagSet.Parse(args[1:]); err != nil {
 		return options{}, errArgParse
 	}
+	if cmd.compareArgs {
+		if flagSet.NArg() != 2 {
+			fmt.Fprintf(flagSet.Output(), "expected 2 args, the previous and current input files, but got %d\n", flagSet.NArg())
+			flagSet.Usage()
+			return options{}, errArgParse
+		}
+		files.Previous, files.Inputs = flagSet.Arg(0), []string{flagSet.Arg(1)}
+	}
+	if cmd.interact != nil && ei.readsStdin(fileArgs{Inputs: files.Inputs}) {
+		fmt.Fprintf(flagSet.Output(), "%s is interactive on STDIN, so input must be a file\n", cmd.name)
+		flagSet.Usage()
+		return options{}, errArgParse
+	}
+	if cmd.interact != nil && (strings.TrimSpace(files.Output) != "-" || *appendOutput) {
+		fmt.Fprintf(flagSet.Output(), "%s is interactive on STDOUT, so output may not be a file\n", cmd.name)
+		flagSet.Usage()
+		return options{}, errArgParse
+	}
+
+	if watch.Enabled && ei.readsStdin(files) {
+		fmt.Fprintln(flagSet.Output(), "--watch needs files to watch, so no input may be STDIN")
+		flagSet.Usage()
+		return options{}, errArgParse
+	}
+	var fixtureArgs []string
+	if cmd.fixtureArgs {
+		fixtureArgs = flagSet.Args()
+	}
+	opts := options{
+		FixtureArgs: fixtureArgs,
+		RowOptions:  rowOpts,
+		Pretty:      pretty,
+		Watch:       watch,
+		Files:       files,
+	}
 
 	// Get the appropriate input and output, given the args.
-	input, err := ei.getFileSource(*inputPtr, stdin, os.O_RDONLY, 0777, errCouldNotOpenInput)
-	if err != nil {
+	if err := ei.openInputs(&opts, stdin); err != nil {
 		flagSet.Usage()
 		return options{}, err
 	}
-	output, err := ei.getFileSource(*outputPtr, stdout, os.O_RDWR|os.O_CREATE, 0755, errCouldNotOpenOutput)
+	// An output file is written once done, see executeToOutput, unless
+	// appending.
+	if strings.TrimSpace(files.Output) != "-" && !*appendOutput {
+		return opts, nil
+	}
+	output, err := ei.getFileSource(files.Output, stdout, os.O_WRONLY|os.O_APPEND|os.O_CREATE, outputPerm, errCouldNotOpenOutput, &opts.Closers)
 	if err != nil {
 		flagSet.Usage()
+		ei.closeAll(opts.Closers)
 		return options{}, err
 	}
+	opts.Output = output.(io.Writer)
+	return opts, nil
+}
+
+// Open the input files given in opts, adding them to its closers. Nothing is
+// left open on failure.
+func (ei *EngineImpl) openInputs(opts *options, stdin io.Reader) error {
+	inputs, err := ei.openNamedInputs(opts.Files.Inputs, stdin, &opts.Closers)
+	if err != nil {
+		ei.closeAll(opts.Closers)
+		opts.Closers = nil
+		return err
+	}
+	opts.Inputs = inputs
+
+	optionalInputs := []struct {
+		arg    string
+		reader *io.Reader
+	}{
+		{opts.Files.Adjustments, &opts.Adjustments},
+		{opts.Files.Rules, &opts.Rules},
+		{opts.Files.Fixtures, &opts.Fixtures},
+		{opts.Files.Hypothetical, &opts.Hypothetical},
+		{opts.Files.Previous, &opts.Previous},
+		{opts.Files.Template, &opts.Template},
+	}
+	for _, input := range optionalInputs {
+		reader, err := ei.getOptionalInput(input.arg, stdin, &opts.Closers)
+		if err != nil {
+			ei.closeAll(opts.Closers)
+			opts.Closers = nil
+			return err
+		}
+		*input.reader = reader
+	}
+	return nil
+}
 
-	return options{
-		Input:  input.(io.Reader),
-		Output: output.(io.Writer),
-	}, nil
+func (ei *EngineImpl) closeAll(closers []io.Closer) {
+	for _, closable := range closers {
+		closable.Close()
+	}
+}
+
+func (ei *EngineImpl) nonNegativeIntFlag(target *int) func(string) error {
+	return func(arg string) error {
+		value, err := strconv.Atoi(arg)
+		if err != nil {
+			return err
+		}
+		if value < 0 {
+			return errors.New("expected zero or more")
+		}
+		*target = value
+		return nil
+	}
+}
+
+// Optional float flags are left nil when the flag is not given.
+func (ei *EngineImpl) optionalFloatFlag(target **float64) func(string) error {
+	return func(arg string) error {
+		value, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return err
+		}
+		*target = &value
+		return nil
+	}
+}
+
+// Optional inputs are nil when the arg is not given.
+func (ei *EngineImpl) getOptionalInput(arg string, stdin io.Reader, closers *[]io.Closer) (io.Reader, error) {
+	if strings.TrimSpace(arg) == "" {
+		return nil, nil
+	}
+
+	input, err := ei.getFileSource(arg, stdin, os.O_RDONLY, 0777, errCouldNotOpenInput, closers)
+	if err != nil {
+		return nil, err
+	}
+	return input.(io.Reader), nil
 }
 
 func (ei *EngineImpl) getFileSource(
@@ -176,6 +613,7 @@ func (ei *EngineImpl) getFileSource(
 	flag int,
 	createPerm os.FileMode,
 	couldNotOpenErr error,
+	closers *[]io.Closer,
 ) (interface{}, error) {
 	cleaned := strings.TrimSpace(arg)
 
@@ -187,5 +625,6 @@ func (ei *EngineImpl) getFileSource(
 	if err != nil {
 		return nil, fmt.Errorf("%s - %w", cleaned, couldNotOpenErr)
 	}
+	*closers = append(*closers, f)
 	return f, nil
 }
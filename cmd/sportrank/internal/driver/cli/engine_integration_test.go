@@ -2,11 +2,15 @@ package cli_test
 
 import (
 	"bytes"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/driver/cli"
 	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/wire"
@@ -93,6 +97,178 @@ func (suite *EngineImplIntegrationTestSuite) TestRun_GivenValidInputAndOutputVia
 	suite.Equal(expectedOutput, string(outputBytes))
 }
 
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenOutputFileWithLongerContent_ShouldReplaceIt() {
+	// Setup fixture
+	dir := suite.T().TempDir()
+	outputFile := filepath.Join(dir, "out.txt")
+	suite.writeFile(outputFile, strings.Repeat("an older, longer table\n", 20))
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "-o", outputFile}
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 6 pts
+2. Lions, 5 pts
+3. FC Awesome, 1 pt
+3. Snakes, 1 pt
+5. Grouches, 0 pts
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, suite.readFile(outputFile))
+	entries, err := os.ReadDir(dir)
+	suite.NoError(err)
+	suite.Len(entries, 1, "temporary output files should not be left behind")
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenNewOutputFile_ShouldNotMakeItExecutable() {
+	if runtime.GOOS == "windows" {
+		suite.T().Skip("file modes are not POSIX on windows")
+	}
+
+	// Setup fixture
+	outputFile := filepath.Join(suite.T().TempDir(), "out.txt")
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "-o", outputFile}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	info, err := os.Stat(outputFile)
+	suite.NoError(err)
+	suite.Zero(info.Mode().Perm()&0111, "mode %s", info.Mode())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenExistingOutputFile_ShouldKeepItsMode() {
+	if runtime.GOOS == "windows" {
+		suite.T().Skip("file modes are not POSIX on windows")
+	}
+
+	// Setup fixture
+	outputFile := filepath.Join(suite.T().TempDir(), "out.txt")
+	suite.writeFile(outputFile, "an older table\n")
+	suite.NoError(os.Chmod(outputFile, 0600))
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "-o", outputFile}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	info, err := os.Stat(outputFile)
+	suite.NoError(err)
+	suite.Equal(os.FileMode(0600), info.Mode().Perm())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenSymlinkedOutputFile_ShouldReplaceItsTarget() {
+	if runtime.GOOS == "windows" {
+		suite.T().Skip("symlinks need privileges on windows")
+	}
+
+	// Setup fixture
+	dir := suite.T().TempDir()
+	targetFile, linkFile := filepath.Join(dir, "target.txt"), filepath.Join(dir, "link.txt")
+	suite.writeFile(targetFile, "an older table\n")
+	suite.NoError(os.Symlink(targetFile, linkFile))
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "-o", linkFile}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	info, err := os.Lstat(linkFile)
+	suite.NoError(err)
+	suite.NotZero(info.Mode()&os.ModeSymlink, "the output symlink should be kept")
+	suite.True(strings.HasPrefix(suite.readFile(targetFile), "1. Tarantulas, 6 pts\n"))
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenUnwritableOutputFile_ShouldFailBeforeReadingInput() {
+	// Setup fixture
+	outputFile := filepath.Join(suite.T().TempDir(), "missing", "out.txt")
+	argsFixture := []string{"prog.name", "-o", outputFile}
+	input := &unreadableReader{}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, input, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.CouldNotWriteOutputCode, actualCode)
+	suite.False(input.read, "input should not be read")
+}
+
+// unreadableReader records whether it was read.
+type unreadableReader struct {
+	read bool
+}
+
+func (ur *unreadableReader) Read(p []byte) (int, error) {
+	ur.read = true
+	return 0, io.EOF
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenInvalidInputAndOutputFile_ShouldLeaveItAsIs() {
+	// Setup fixture
+	dir := suite.T().TempDir()
+	outputFile := filepath.Join(dir, "out.txt")
+	suite.writeFile(outputFile, "1. Tarantulas, 6 pts\n")
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "invalid_input.txt"), "-o", outputFile}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+	suite.Equal("1. Tarantulas, 6 pts\n", suite.readFile(outputFile))
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenAppend_ShouldAppendToOutputFile() {
+	// Setup fixture
+	dir := suite.T().TempDir()
+	outputFile := filepath.Join(dir, "out.txt")
+	suite.writeFile(outputFile, "Round 1\n")
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "-o", outputFile, "--append"}
+
+	// Setup expectations
+	expectedOutput := `Round 1
+1. Tarantulas, 6 pts
+2. Lions, 5 pts
+3. FC Awesome, 1 pt
+3. Snakes, 1 pt
+5. Grouches, 0 pts
+1. Tarantulas, 6 pts
+2. Lions, 5 pts
+3. FC Awesome, 1 pt
+3. Snakes, 1 pt
+5. Grouches, 0 pts
+`
+
+	// Exercise SUT
+	firstCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+	secondCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, firstCode)
+	suite.Equal(cli.SuccessCode, secondCode)
+	suite.Equal(expectedOutput, suite.readFile(outputFile))
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenAppendToNewFile_ShouldCreateIt() {
+	// Setup fixture
+	outputFile := filepath.Join(suite.T().TempDir(), "out.txt")
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "-o", outputFile, "--append"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.True(strings.HasPrefix(suite.readFile(outputFile), "1. Tarantulas, 6 pts\n"))
+}
+
 func (suite *EngineImplIntegrationTestSuite) TestRun_GivenInvalidInput_ShouldReturnInvalidFormat() {
 	// Setup fixture
 	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "invalid_input.txt")}
@@ -138,3 +314,1187 @@ func (suite *EngineImplIntegrationTestSuite) TestRun_GivenInvalidArgs_ShouldRetu
 	// Verify results
 	suite.Equal(cli.FlagParseErrorCode, actualCode)
 }
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenScoreAboveMaxScore_ShouldReturnInvalidFormat() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--max-score", "3"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenNegativeMaxScore_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--max-score", "-1"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenAdjustments_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--adjustments", path.Join("testdata", "valid_adjustments.txt"), "--annotate-adjustments"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 6 pts
+2. Lions*, 2 pts
+3. FC Awesome, 1 pt
+3. Grouches*, 1 pt
+3. Snakes, 1 pt
+
+* Lions: -3 pts (financial breach)
+* Grouches: +1 pt
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenInvalidAdjustments_ShouldReturnInvalidFormat() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--adjustments", path.Join("testdata", "invalid_adjustments.txt")}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenRules_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--rules", path.Join("testdata", "rugby.yaml")}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Lions, 8 pts
+1. Tarantulas, 8 pts
+3. FC Awesome, 3 pts
+3. Snakes, 3 pts
+5. Grouches, 1 pt
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenInvalidRules_ShouldReturnInvalidFormat() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--rules", path.Join("testdata", "invalid_input.txt")}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenSport_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--sport", "chess"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Lions, 2 pts
+1. Tarantulas, 2 pts
+3. FC Awesome, 0.5 pts
+3. Snakes, 0.5 pts
+5. Grouches, 0 pts
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenUnknownSport_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--sport", "quidditch"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenRankByGlicko2_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--rank-by", "glicko2"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 1747 (RD 253, vol 0.0600)
+2. Lions, 1600 (RD 228, vol 0.0600)
+3. FC Awesome, 1376 (RD 253, vol 0.0600)
+3. Snakes, 1376 (RD 253, vol 0.0600)
+5. Grouches, 1338 (RD 290, vol 0.0600)
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenRankByBradleyTerry_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--rank-by", "bradley-terry"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 9.748 (57.9% vs average)
+2. Lions, 1.463 (30.4% vs average)
+3. FC Awesome, 0.657 (20.3% vs average)
+3. Snakes, 0.657 (20.3% vs average)
+5. Grouches, 0.259 (11.5% vs average)
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenRankByMassey_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--rank-by", "massey"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 2.00
+2. FC Awesome, 0.75
+3. Lions, 0.50
+4. Snakes, 0.25
+5. Grouches, -3.50
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenStrengthOfSchedule_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--strength-of-schedule"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 6 pts, SoS 0.50 (opp. opp. 2.33)
+2. Lions, 5 pts, SoS 0.33 (opp. opp. 2.11)
+3. FC Awesome, 1 pt, SoS 2.33 (opp. opp. 0.42)
+3. Snakes, 1 pt, SoS 2.33 (opp. opp. 0.42)
+5. Grouches, 0 pts, SoS 1.67 (opp. opp. 0.33)
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenFixtures_ShouldMarkTeams() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--fixtures", path.Join("testdata", "fixtures.txt"), "--playoff-spots", "2"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 6 pts
+2. Lions, 5 pts
+3. e-FC Awesome, 1 pt
+3. Snakes, 1 pt
+5. Grouches, 0 pts
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenUnknownRankBy_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--rank-by", "vibes"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenMarkdownOutput_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--output-format", "markdown"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `| Rank | Team | Pts |
+| ---: | --- | ---: |
+| 1 | Tarantulas | 6 |
+| 2 | Lions | 5 |
+| 3 | FC Awesome | 1 |
+| 3 | Snakes | 1 |
+| 5 | Grouches | 0 |
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenHTMLOutput_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--output-format", "html"}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.True(strings.HasPrefix(output.String(), "<!DOCTYPE html>\n"))
+	suite.Contains(output.String(), `<tr><td class="numeric">1</td><td>Tarantulas</td><td class="numeric">6</td></tr>`)
+	suite.True(strings.HasSuffix(output.String(), "</html>\n"))
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenUnknownOutputFormat_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--output-format", "pdf"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenTemplate_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--template", path.Join("testdata", "standings.tmpl")}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1st  Tarantulas    6 pts (P2 W2 D0 L0)
+2nd  Lions         5 pts (P3 W1 D2 L0)
+3rd  FC Awesome    1 pt (P2 W0 D1 L1)
+3rd  Snakes        1 pt (P2 W0 D1 L1)
+5th  Grouches      0 pts (P1 W0 D0 L1)
+
+Generated by sportrank
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenInvalidTemplate_ShouldReturnInvalidFormat() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--template", path.Join("testdata", "invalid_template.tmpl")}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenLocale_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--locale", "af"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 6 punte
+2. Lions, 5 punte
+3. FC Awesome, 1 punt
+3. Snakes, 1 punt
+5. Grouches, 0 punte
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenUnknownLocale_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--locale", "xx"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenRankingStyleAndTieMarker_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--ranking-style", "dense", "--tie-marker", "T"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 6 pts
+2. Lions, 5 pts
+T3. FC Awesome, 1 pt
+T3. Snakes, 1 pt
+4. Grouches, 0 pts
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenUnknownRankingStyle_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--ranking-style", "fractional"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenFixturesAndRankByRatingMethod_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--fixtures", path.Join("testdata", "fixtures.txt"), "--rank-by", "colley"}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+	suite.Empty(output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenUnknownRankingStyleAndRankByRatingMethod_ShouldReturnFlagParseError() {
+	for _, rankBy := range []string{"glicko2", "bradley-terry", "colley", "massey"} {
+		suite.Run(rankBy, func() {
+			// Setup fixture
+			argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+				"--rank-by", rankBy, "--ranking-style", "bogus"}
+
+			// Exercise SUT
+			actualCode := suite.sut.Run(argsFixture, nil, io.Discard)
+
+			// Verify results
+			suite.Equal(cli.FlagParseErrorCode, actualCode)
+		})
+	}
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenColorAlways_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--color", "always", "--promotion-spots", "1", "--relegation-spots", "1"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := "\x1b[1;32m1. Tarantulas  6 pts\x1b[0m\n" +
+		"2. Lions       5 pts\n" +
+		"3. FC Awesome  1 pt\n" +
+		"3. Snakes      1 pt\n" +
+		"\x1b[31m5. Grouches    0 pts\x1b[0m\n"
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenColorAlwaysAndMarkdown_ShouldNotColor() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--color", "always", "--output-format", "markdown"}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.True(strings.HasPrefix(output.String(), "| Rank | Team | Pts |\n"))
+	suite.NotContains(output.String(), "\x1b[")
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenColorNever_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"),
+		"--color", "never", "--promotion-spots", "1"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 6 pts
+2. Lions, 5 pts
+3. FC Awesome, 1 pt
+3. Snakes, 1 pt
+5. Grouches, 0 pts
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenUnknownColor_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--color", "sometimes"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenSimulate_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "simulate", "-i", path.Join("testdata", "valid_input.txt"),
+		"--fixtures", path.Join("testdata", "fixtures.txt"), "--seasons", "1000"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `Team, 1, 2, 3, 4, 5
+Tarantulas, 96.4%, 3.1%, 0.5%, 0.0%, 0.0%
+Lions, 3.5%, 64.5%, 31.9%, 0.0%, 0.0%
+Snakes, 0.1%, 32.0%, 42.5%, 20.6%, 4.7%
+FC Awesome, 0.0%, 0.0%, 21.9%, 67.8%, 10.2%
+Grouches, 0.0%, 0.4%, 3.0%, 11.6%, 85.0%
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenSimulateWithInvalidFixtures_ShouldReturnInvalidFormat() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "simulate", "-i", path.Join("testdata", "valid_input.txt"),
+		"--fixtures", path.Join("testdata", "invalid_input.txt")}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenPredict_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "predict", "-i", path.Join("testdata", "valid_input.txt"),
+		"Lions, Tarantulas", "Snakes, FC Awesome"}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `Lions vs Tarantulas
+Expected score: 1.17 - 1.85
+Lions win 20.7%, draw 29.3%, Tarantulas win 50.0%
+Most likely scores: 1-1 (13.9%), 1-2 (9.8%), 0-2 (8.4%), 0-0 (8.2%), 2-1 (6.2%)
+
+Snakes vs FC Awesome
+Expected score: 1.45 - 1.43
+Snakes win 34.4%, draw 32.1%, FC Awesome win 33.5%
+Most likely scores: 1-1 (15.2%), 0-0 (9.2%), 2-1 (8.4%), 1-2 (8.3%), 2-2 (6.1%)
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenPredictWithFixturesFile_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "predict", "-i", path.Join("testdata", "valid_input.txt"),
+		"--fixtures", path.Join("testdata", "fixtures.txt"), "--home-advantage"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, bytes.NewBufferString(""))
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenPredictWithUnknownTeam_ShouldReturnInvalidFormat() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "predict", "-i", path.Join("testdata", "valid_input.txt"),
+		"Lions, Bears"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenWhatIf_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "whatif", "-i", path.Join("testdata", "valid_input.txt"),
+		"--hypothetical", path.Join("testdata", "hypothetical.txt")}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `Before               | After
+1. Tarantulas, 6 pts | 1. Lions, 8 pts (up 1, +3 pts)
+2. Lions, 5 pts      | 2. Tarantulas, 6 pts (down 1, +0 pts)
+3. FC Awesome, 1 pt  | 3. Snakes, 2 pts (same, +1 pt)
+3. Snakes, 1 pt      | 4. Bears, 1 pt (new)
+5. Grouches, 0 pts   | 4. FC Awesome, 1 pt (down 1, +0 pts)
+                     | 6. Grouches, 0 pts (down 1, +0 pts)
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenWhatIfWithInvalidHypothetical_ShouldReturnInvalidFormat() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "whatif", "-i", path.Join("testdata", "valid_input.txt"),
+		"--hypothetical", path.Join("testdata", "invalid_input.txt")}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenDiff_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "diff",
+		path.Join("testdata", "valid_input.txt"), path.Join("testdata", "corrected_input.txt")}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `Lions: 2. -> 1. (up 1), 5 -> 5 pts (+0 pts)
+Snakes: 3. -> 2. (up 1), 1 -> 4 pts (+3 pts)
+Tarantulas: 1. -> 3. (down 2), 6 -> 3 pts (-3 pts)
+FC Awesome: 3. -> 4. (down 1), 1 -> 1 pt (+0 pts)
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenDiffAsJSON_ShouldReturnSuccess() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "diff", "--output-format", "json",
+		path.Join("testdata", "valid_input.txt"), path.Join("testdata", "valid_input.txt")}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal("[]\n", output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenDiffWithOneFile_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "diff", path.Join("testdata", "valid_input.txt")}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenDiffWithUnknownOutputFormat_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "diff", "--output-format", "yaml",
+		path.Join("testdata", "valid_input.txt"), path.Join("testdata", "corrected_input.txt")}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenDiffWithMissingFile_ShouldReturnCouldNotReadInput() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "diff",
+		path.Join("testdata", "does_not_exist.txt"), path.Join("testdata", "valid_input.txt")}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.CouldNotReadInputCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenSimulateWithRankingFlag_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "simulate", "-i", path.Join("testdata", "valid_input.txt"),
+		"--rank-by", "glicko2"}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, os.Stdout)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenTUIAndTeamSelected_ShouldShowTeamGames() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "tui", "-i", path.Join("testdata", "valid_input.txt")}
+	keysFixture := strings.NewReader("j\rq")
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedScreen := "Lions - games\r\n" +
+		"\r\n" +
+		"D 3-3 vs Snakes\r\n" +
+		"D 1-1 vs FC Awesome\r\n" +
+		"W 4-0 vs Grouches\r\n" +
+		"\r\n" +
+		"↑/↓ scroll  esc back  q quit"
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, keysFixture, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedScreen, suite.lastScreen(output.String()))
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenTUIAndSortKey_ShouldSortByTeam() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "tui", "-i", path.Join("testdata", "valid_input.txt")}
+	keysFixture := strings.NewReader("s")
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedScreen := "Ranked by points, sorted by Team ascending\r\n" +
+		"\r\n" +
+		"Rank  Team        Pts\r\n" +
+		"   3  FC Awesome    1\r\n" +
+		"   5  Grouches      0\r\n" +
+		"   2  Lions         5\r\n" +
+		"   3  Snakes        1\r\n" +
+		"\x1b[7m   1  Tarantulas    6\x1b[0m\r\n" +
+		"\r\n" +
+		"↑/↓ move  enter games  s sort  r reverse  m method  q quit"
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, keysFixture, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedScreen, suite.lastScreen(output.String()))
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenTUIAndResortKey_ShouldKeepTeamSelected() {
+	for _, key := range []string{"s", "r", "m"} {
+		suite.Run(key, func() {
+			// Setup fixture
+			argsFixture := []string{"prog.name", "tui", "-i", path.Join("testdata", "valid_input.txt")}
+			keysFixture := strings.NewReader("j" + key + "\rq")
+			output := bytes.NewBufferString("")
+
+			// Exercise SUT
+			actualCode := suite.sut.Run(argsFixture, keysFixture, output)
+
+			// Verify results
+			suite.Equal(cli.SuccessCode, actualCode)
+			suite.True(strings.HasPrefix(suite.lastScreen(output.String()), "Lions - games\r\n"))
+		})
+	}
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenTUIAndMethodKey_ShouldRankByGlicko2() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "tui", "-i", path.Join("testdata", "valid_input.txt")}
+	keysFixture := strings.NewReader("m")
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, keysFixture, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	screen := suite.lastScreen(output.String())
+	suite.True(strings.HasPrefix(screen, "Ranked by glicko2, sorted by Rank ascending\r\n"))
+	suite.Contains(screen, "Rank  Team        Rating   RD  Volatility\r\n")
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenTUIWithInputFromStdin_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "tui", "-i", "-"}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, strings.NewReader(""), output)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenTUIWithOutputFile_ShouldReturnFlagParseError() {
+	// Setup fixture
+	outputPath := path.Join(suite.T().TempDir(), "output.txt")
+	argsFixture := []string{"prog.name", "tui", "-i", path.Join("testdata", "valid_input.txt"), "-o", outputPath}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, strings.NewReader("q"), output)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+	suite.Empty(output.String())
+	suite.NoFileExists(outputPath)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenREPLWithAppend_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "repl", "--append"}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, strings.NewReader("table\n"), output)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+	suite.Empty(output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenTUIWithUnknownRankBy_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "tui", "-i", path.Join("testdata", "valid_input.txt"), "--rank-by", "elo"}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, strings.NewReader(""), output)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+// The last screen drawn by the TUI, without the codes to leave it.
+func (suite *EngineImplIntegrationTestSuite) lastScreen(output string) string {
+	screens := strings.Split(output, "\x1b[H\x1b[2J")
+	return strings.TrimSuffix(screens[len(screens)-1], "\x1b[?25h\x1b[?1049l")
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenREPLResultsAndTable_ShouldShowStandings() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "repl"}
+	linesFixture := strings.NewReader(`Lions 3, Snakes 3
+Lions 2
+Tarantulas 1, FC Awesome 0
+:table
+`)
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := "error: could not convert row at STDIN:2: expected 2 sections after splitting by comma but got 1: " +
+		"input row is malformed, it should be of the form <TeamA> <ScoreA>, <TeamB> <ScoreB>\n" +
+		`1. Tarantulas, 3 pts
+2. Lions, 1 pt
+2. Snakes, 1 pt
+4. FC Awesome, 0 pts
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, linesFixture, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenREPLAfterInputFile_ShouldGiveLineOfMalformedResult() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "repl", "-i", path.Join("testdata", "valid_input.txt")}
+	linesFixture := strings.NewReader(`:table
+
+Lions 2
+`)
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, linesFixture, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Contains(output.String(), "error: could not convert row at STDIN:3: ")
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenREPLUndoAndSave_ShouldSaveResults() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "repl", "-i", path.Join("testdata", "valid_input.txt")}
+	linesFixture := strings.NewReader(`Snakes 2, Grouches 1
+:undo
+:undo
+:save ` + path.Join("testdata", "temptestout.txt") + `
+:quit
+Snakes 2, Grouches 1
+`)
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `removed: Snakes 2, Grouches 1
+removed: Lions 4, Grouches 0
+saved 4 rows to ` + path.Join("testdata", "temptestout.txt") + `
+`
+	expectedSaved := `Lions 3, Snakes 3
+Tarantulas 1, FC Awesome 0
+Lions 1, FC Awesome 1
+Tarantulas 3, Snakes 1
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, linesFixture, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+	savedBytes, err := ioutil.ReadFile(path.Join("testdata", "temptestout.txt"))
+	suite.NoError(err)
+	suite.Equal(expectedSaved, string(savedBytes))
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenREPLUnknownCommand_ShouldShowError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "repl"}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, strings.NewReader(":undo\n:tabel\n"), output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal("nothing to undo\nerror: unknown command :tabel, enter :help for help\n", output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenREPLReadingNullDevice_ShouldNotPrompt() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "repl"}
+	stdinFixture, err := os.Open(os.DevNull)
+	suite.Require().NoError(err)
+	defer stdinFixture.Close()
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, stdinFixture, output)
+
+	// Verify results
+	// -> The null device is a character device, but not a terminal.
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Empty(output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenREPLWithMalformedInputFile_ShouldReturnInvalidFormat() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "repl", "-i", path.Join("testdata", "invalid_input.txt")}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, strings.NewReader(""), output)
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenWatchAndInputChanges_ShouldRewriteOutput() {
+	if runtime.GOOS == "windows" {
+		suite.T().Skip("interrupting the watch needs a signal")
+	}
+
+	// Setup fixture
+	dir := suite.T().TempDir()
+	inputFile, outputFile := filepath.Join(dir, "input.txt"), filepath.Join(dir, "out.txt")
+	suite.writeFile(inputFile, "Lions 3, Snakes 3\n")
+	argsFixture := []string{"prog.name", "-i", inputFile, "-o", outputFile, "--watch", "--watch-interval", "10ms"}
+
+	// Setup expectations
+	expectedFirst := `1. Lions, 1 pt
+1. Snakes, 1 pt
+`
+	expectedSecond := `1. Snakes, 4 pts
+2. Lions, 1 pt
+`
+
+	// Exercise SUT
+	codes := make(chan int, 1)
+	go func() {
+		codes <- suite.sut.Run(argsFixture, nil, bytes.NewBufferString(""))
+	}()
+
+	// Verify results
+	suite.Eventually(func() bool { return suite.readFile(outputFile) == expectedFirst }, 5*time.Second, 10*time.Millisecond)
+
+	// A malformed edit leaves the output as it was.
+	suite.writeFile(inputFile, "Lions 3, Snakes 3\nSnakes 1\n")
+	time.Sleep(100 * time.Millisecond)
+	suite.Equal(expectedFirst, suite.readFile(outputFile))
+
+	suite.writeFile(inputFile, "Lions 3, Snakes 3\nSnakes 1, Lions 0\n")
+	suite.Eventually(func() bool { return suite.readFile(outputFile) == expectedSecond }, 5*time.Second, 10*time.Millisecond)
+
+	process, err := os.FindProcess(os.Getpid())
+	suite.NoError(err)
+	suite.NoError(process.Signal(os.Interrupt))
+	select {
+	case code := <-codes:
+		suite.Equal(cli.SuccessCode, code)
+	case <-time.After(5 * time.Second):
+		suite.Fail("watch did not stop when interrupted")
+	}
+	entries, err := os.ReadDir(dir)
+	suite.NoError(err)
+	suite.Len(entries, 2, "temporary output files should not be left behind")
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenWatchAndNewFileMatchingGlob_ShouldRewriteOutput() {
+	if runtime.GOOS == "windows" {
+		suite.T().Skip("interrupting the watch needs a signal")
+	}
+
+	// Setup fixture
+	dir := suite.T().TempDir()
+	outputFile := filepath.Join(dir, "out.txt")
+	suite.NoError(os.Mkdir(filepath.Join(dir, "in"), 0755))
+	suite.writeFile(filepath.Join(dir, "in", "a.txt"), "Lions 3, Snakes 3\n")
+	argsFixture := []string{"prog.name", "-i", filepath.Join(dir, "in", "*.txt"), "-o", outputFile,
+		"--watch", "--watch-interval", "10ms"}
+
+	// Setup expectations
+	expectedFirst := `1. Lions, 1 pt
+1. Snakes, 1 pt
+`
+	expectedSecond := `1. Snakes, 4 pts
+2. Lions, 1 pt
+`
+
+	// Exercise SUT
+	codes := make(chan int, 1)
+	go func() {
+		codes <- suite.sut.Run(argsFixture, nil, bytes.NewBufferString(""))
+	}()
+
+	// Verify results
+	suite.Eventually(func() bool { return suite.readFile(outputFile) == expectedFirst }, 5*time.Second, 10*time.Millisecond)
+
+	suite.writeFile(filepath.Join(dir, "in", "b.txt"), "Snakes 1, Lions 0\n")
+	suite.Eventually(func() bool { return suite.readFile(outputFile) == expectedSecond }, 5*time.Second, 10*time.Millisecond)
+
+	process, err := os.FindProcess(os.Getpid())
+	suite.NoError(err)
+	suite.NoError(process.Signal(os.Interrupt))
+	select {
+	case code := <-codes:
+		suite.Equal(cli.SuccessCode, code)
+	case <-time.After(5 * time.Second):
+		suite.Fail("watch did not stop when interrupted")
+	}
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenWatchWithInputFromStdin_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", "-", "--watch"}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, strings.NewReader(""), output)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenWatchWithNonPositiveInterval_ShouldReturnFlagParseError() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", path.Join("testdata", "valid_input.txt"), "--watch", "--watch-interval", "0s"}
+	output := bytes.NewBufferString("")
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.FlagParseErrorCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) writeFile(file string, content string) {
+	if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+		suite.FailNow("could not write test file, please check")
+	}
+}
+
+// The file's content, or empty if it cannot be read, e.g. before it is written.
+func (suite *EngineImplIntegrationTestSuite) readFile(file string) string {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return ""
+	}
+	return string(content)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenRepeatedInputs_ShouldRankAllResults() {
+	// Setup fixture
+	dir := suite.T().TempDir()
+	suite.writeFile(filepath.Join(dir, "jan.txt"), "Lions 3, Snakes 3\nTarantulas 1, FC Awesome 0\n")
+	suite.writeFile(filepath.Join(dir, "feb.txt"), "Lions 1, FC Awesome 1\n\nTarantulas 3, Snakes 1\nLions 4, Grouches 0\n")
+	argsFixture := []string{"prog.name", "-i", filepath.Join(dir, "jan.txt"), "-i", filepath.Join(dir, "feb.txt")}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Tarantulas, 6 pts
+2. Lions, 5 pts
+3. FC Awesome, 1 pt
+3. Snakes, 1 pt
+5. Grouches, 0 pts
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenInputGlob_ShouldRankAllMatchingFiles() {
+	// Setup fixture
+	dir := suite.T().TempDir()
+	suite.writeFile(filepath.Join(dir, "2023-01.txt"), "round 1\nLions 3, Snakes 3\n")
+	suite.writeFile(filepath.Join(dir, "2023-02.txt"), "round 2\nSnakes 1, Lions 0\n")
+	suite.writeFile(filepath.Join(dir, "notes.md"), "Not results\n")
+	argsFixture := []string{"prog.name", "-i", filepath.Join(dir, "*.txt")}
+	output := bytes.NewBufferString("")
+
+	// Setup expectations
+	expectedOutput := `1. Snakes, 4 pts
+2. Lions, 1 pt
+`
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, output)
+
+	// Verify results
+	suite.Equal(cli.SuccessCode, actualCode)
+	suite.Equal(expectedOutput, output.String())
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenInputGlobWithNoMatches_ShouldReturnCouldNotReadInput() {
+	// Setup fixture
+	argsFixture := []string{"prog.name", "-i", filepath.Join(suite.T().TempDir(), "*.txt")}
+
+	// Exercise SUT
+	actualCode := suite.sut.Run(argsFixture, nil, bytes.NewBufferString(""))
+
+	// Verify results
+	suite.Equal(cli.CouldNotReadInputCode, actualCode)
+}
+
+func (suite *EngineImplIntegrationTestSuite) TestRun_GivenMalformedRowInSecondInput_ShouldReportFileAndLine() {
+	// Setup fixture
+	dir := suite.T().TempDir()
+	janFile, febFile := filepath.Join(dir, "jan.txt"), filepath.Join(dir, "feb.txt")
+	suite.writeFile(janFile, "Lions 3, Snakes 3\n")
+	suite.writeFile(febFile, "Lions 1, FC Awesome 1\n\nTarantulas 3\n")
+	argsFixture := []string{"prog.name", "-i", janFile, "-i", febFile}
+
+	// Exercise SUT
+	var actualCode int
+	errOutput := suite.captureStderr(func() {
+		actualCode = suite.sut.Run(argsFixture, nil, bytes.NewBufferString(""))
+	})
+
+	// Verify results
+	suite.Equal(cli.InvalidFormatCode, actualCode)
+	suite.Contains(errOutput, "could not convert row at "+febFile+":3: ")
+}
+
+// Anything written to STDERR while running f.
+func (suite *EngineImplIntegrationTestSuite) captureStderr(f func()) string {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		suite.FailNow("could not create pipe, please check")
+	}
+	original := os.Stderr
+	os.Stderr = writer
+	defer func() { os.Stderr = original }()
+
+	captured := make(chan string)
+	go func() {
+		content, _ := ioutil.ReadAll(reader)
+		captured <- string(content)
+	}()
+	f()
+	writer.Close()
+	return <-captured
+}
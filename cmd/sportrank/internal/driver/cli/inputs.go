@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// inputsFlag collects each -i given, in order. The default applies only if
+// none is given.
+type inputsFlag struct {
+	args  *[]string
+	given bool
+}
+
+func (f *inputsFlag) String() string {
+	if f.args == nil {
+		return ""
+	}
+	return strings.Join(*f.args, ", ")
+}
+
+func (f *inputsFlag) Set(arg string) error {
+	if !f.given {
+		*f.args, f.given = nil, true
+	}
+	*f.args = append(*f.args, arg)
+	return nil
+}
+
+// namedInput is an opened input, and its name for error messages.
+type namedInput struct {
+	Name   string
+	Reader io.Reader
+}
+
+// The name of STDIN in error messages.
+const stdinName = "STDIN"
+
+// Args with glob patterns, e.g. results/*.txt, are expanded to the files
+// which match, in order. Other args are as given. Patterns which match no
+// files are an error, but the rest are still expanded.
+func (ei *EngineImpl) expandInputArgs(args []string) ([]string, error) {
+	var expanded []string
+	var firstErr error
+	for _, arg := range args {
+		cleaned := strings.TrimSpace(arg)
+		if cleaned == "" {
+			continue
+		}
+		if !strings.ContainsAny(cleaned, "*?[") {
+			expanded = append(expanded, cleaned)
+			continue
+		}
+
+		matches, err := filepath.Glob(cleaned)
+		if (err != nil || len(matches) == 0) && firstErr == nil {
+			firstErr = fmt.Errorf("%s - %w, no files match", cleaned, errCouldNotOpenInput)
+		}
+		expanded = append(expanded, matches...)
+	}
+	return expanded, firstErr
+}
+
+// Open each input arg, after expanding globs. - is STDIN.
+func (ei *EngineImpl) openNamedInputs(args []string, stdin io.Reader, closers *[]io.Closer) ([]namedInput, error) {
+	expanded, err := ei.expandInputArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]namedInput, 0, len(expanded))
+	for _, arg := range expanded {
+		reader, err := ei.getOptionalInput(arg, stdin, closers)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, namedInput{Name: ei.inputName(arg), Reader: reader})
+	}
+	return inputs, nil
+}
+
+// The name of the input arg in error messages. - is STDIN.
+func (ei *EngineImpl) inputName(arg string) string {
+	if arg == "-" {
+		return stdinName
+	}
+	return arg
+}
+
+// The non-blank lines of each input, in order, and where each came from, as
+// <Name>:<Line>.
+func (ei *EngineImpl) readNamedInputs(inputs []namedInput) ([]string, []string, error) {
+	var lines, sources []string
+	for _, input := range inputs {
+		scanner := bufio.NewScanner(input.Reader)
+		for number := 1; scanner.Scan(); number++ {
+			line := scanner.Text()
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			lines = append(lines, line)
+			sources = append(sources, fmt.Sprintf("%s:%d", input.Name, number))
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, nil, fmt.Errorf("could not parse input %s: %w", input.Name, err)
+		}
+	}
+	return lines, sources, nil
+}
+
+// As readNamedInputs, for an input which may not have been given, opened from
+// arg.
+func (ei *EngineImpl) readOptionalNamedInput(arg string, input io.Reader) ([]string, []string, error) {
+	if input == nil {
+		return nil, nil, nil
+	}
+	return ei.readNamedInputs([]namedInput{{Name: ei.inputName(strings.TrimSpace(arg)), Reader: input}})
+}
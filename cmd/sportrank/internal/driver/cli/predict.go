@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"flag"
+
+	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/adapter"
+)
+
+// The predict command gives the likely outcomes of fixtures, given as args
+// (e.g. "Lions, Snakes") or in a file, according to a model of each team's
+// attack and defence fitted to the games played.
+func (ei *EngineImpl) predictCommand() command {
+	var predictOpts adapter.PredictionOptions
+	return command{
+		name: "predict",
+		defineFlags: func(flagSet *flag.FlagSet, rowOpts *adapter.Options, files *fileArgs) {
+			flagSet.StringVar(&files.Fixtures, "fixtures", "",
+				"Optional file of fixtures to predict, one \"<TeamA>, <TeamB>\" per line, or - for STDIN.")
+			flagSet.BoolVar(&predictOpts.HomeAdvantage, "home-advantage", false,
+				"Treat the first team of each game and fixture as the home team.")
+		},
+		fixtureArgs: true,
+		execute: func(inputRows []string, rowOpts adapter.Options, rows fileRows) ([]string, error) {
+			predictOpts.FixtureRows = rows.Fixtures
+			return ei.rowIOGateway.PredictFixtures(inputRows, rowOpts, predictOpts)
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// When to colour output, for the --color flag.
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
+)
+
+// ANSI escape codes.
+const (
+	ansiReset = "\x1b[0m"
+	ansiBold  = "1"
+	ansiRed   = "31"
+	ansiGreen = "32"
+)
+
+// Set to anything to disable colour when --color is auto, see no-color.org.
+const noColorEnv = "NO_COLOR"
+
+// prettyArgs customise text rankings written to a terminal.
+type prettyArgs struct {
+	Color           string
+	PromotionSpots  uint
+	RelegationSpots uint
+}
+
+func (ei *EngineImpl) definePrettyFlags(flagSet *flag.FlagSet, pretty *prettyArgs) {
+	pretty.Color = colorAuto
+	flagSet.Func("color",
+		fmt.Sprintf("When to align and colour the rankings, one of: %s. (default %s, when writing to a terminal and %s is not set)",
+			strings.Join([]string{colorAuto, colorAlways, colorNever}, ", "), colorAuto, noColorEnv),
+		func(arg string) error {
+			cleaned := strings.ToLower(strings.TrimSpace(arg))
+			switch cleaned {
+			case colorAuto, colorAlways, colorNever:
+				pretty.Color = cleaned
+				return nil
+			default:
+				return fmt.Errorf("expected one of: %s, %s, %s", colorAuto, colorAlways, colorNever)
+			}
+		})
+	flagSet.UintVar(&pretty.PromotionSpots, "promotion-spots", 0,
+		"The number of teams at the top to colour green, with --color.")
+	flagSet.UintVar(&pretty.RelegationSpots, "relegation-spots", 0,
+		"The number of teams at the bottom to colour red, with --color.")
+}
+
+// Rankings are aligned on a terminal, unless --color is never. The adapter
+// aligns them, see adapter.Options.AlignText. --color always aligns them
+// wherever the output goes.
+func (ei *EngineImpl) alignRows(pretty prettyArgs, output io.Writer) bool {
+	return pretty.Color == colorAlways || (pretty.Color == colorAuto && ei.isTerminal(output))
+}
+
+// Rankings are coloured on a terminal, unless NO_COLOR is set. --color always
+// colours them wherever the output goes.
+func (ei *EngineImpl) prettifyRows(rows []string, pretty prettyArgs, output io.Writer) []string {
+	color := pretty.Color == colorAlways ||
+		(pretty.Color == colorAuto && ei.isTerminal(output) && os.Getenv(noColorEnv) == "")
+	if !color {
+		return rows
+	}
+
+	// Footnotes follow the rankings after a blank row.
+	count := 0
+	for count < len(rows) && rows[count] != "" {
+		count++
+	}
+
+	prettified := append([]string{}, rows...)
+	for i := 0; i < count; i++ {
+		prettified[i] = ei.colorRankingRow(rows[i], i, count, pretty)
+	}
+	return prettified
+}
+
+// Whether the stream, e.g. STDIN or STDOUT, is an interactive terminal.
+func (ei *EngineImpl) isTerminal(stream interface{}) bool {
+	f, ok := stream.(*os.File)
+	return ok && ei.isTerminalFile(f)
+}
+
+// Leaders are bold, and the promotion and relegation zones are green and red.
+// position is the row's index among the count rankings.
+func (ei *EngineImpl) colorRankingRow(row string, position int, count int, pretty prettyArgs) string {
+	var codes []string
+	// Aligned ranks are padded on the left.
+	if rank, _, ok := strings.Cut(strings.TrimSpace(row), ". "); ok && strings.TrimLeftFunc(rank, ei.isNotDigit) == "1" {
+		codes = append(codes, ansiBold)
+	}
+	switch {
+	case uint(position) < pretty.PromotionSpots:
+		codes = append(codes, ansiGreen)
+	case uint(count-position) <= pretty.RelegationSpots:
+		codes = append(codes, ansiRed)
+	}
+
+	if len(codes) == 0 {
+		return row
+	}
+	return "\x1b[" + strings.Join(codes, ";") + "m" + row + ansiReset
+}
+
+func (ei *EngineImpl) isNotDigit(r rune) bool {
+	return !unicode.IsDigit(r)
+}
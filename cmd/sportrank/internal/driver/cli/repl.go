@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/adapter"
+)
+
+// The repl command reads results one line at a time, e.g. as a scorer enters
+// them during a tournament, checking each as it is entered.
+func (ei *EngineImpl) replCommand() command {
+	return command{
+		name: "repl",
+		defineFlags: func(flagSet *flag.FlagSet, rowOpts *adapter.Options, files *fileArgs) {
+			flagSet.StringVar(&rowOpts.RankBy, "rank-by", adapter.RankByNames()[0],
+				fmt.Sprintf("How to rank teams for :table, one of: %s.", strings.Join(adapter.RankByNames(), ", ")))
+		},
+		interact: ei.runREPL,
+	}
+}
+
+// Lines starting with this are commands, rather than results.
+const replCommandPrefix = ":"
+
+// Commands which may be entered.
+const (
+	replTable = ":table"
+	replUndo  = ":undo"
+	replSave  = ":save"
+	replHelp  = ":help"
+	replQuit  = ":quit"
+)
+
+const (
+	replPrompt   = "> "
+	replHelpText = `Enter a result per line, e.g. Lions 3, Snakes 3, or a command:
+  :table        show the current standings
+  :undo         remove the last result
+  :save <file>  write the results to a file
+  :help         show this help
+  :quit         exit, as does Ctrl-D`
+)
+
+func (ei *EngineImpl) runREPL(inputRows []string, rowOpts adapter.Options, stdin io.Reader, stdout io.Writer) error {
+	// Fail before reading anything if the results so far are malformed.
+	if err := ei.rowIOGateway.ValidateInput(inputRows, rowOpts); err != nil {
+		return err
+	}
+	repl := &replSession{
+		rowIOGateway: ei.rowIOGateway,
+		writeFile:    ei.writeFileAtomically,
+		rows:         inputRows,
+		sources:      rowOpts.InputSources,
+		rowOpts:      rowOpts,
+	}
+
+	// Only prompt a person, rather than, e.g., a script.
+	prompt := ""
+	if ei.isTerminal(stdin) {
+		prompt = replPrompt
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for {
+		if _, err := io.WriteString(stdout, prompt); err != nil {
+			return fmt.Errorf("could not write to output: %w", err)
+		}
+		if !scanner.Scan() {
+			break
+		}
+
+		lines, quit := repl.handleLine(scanner.Text())
+		if err := ei.writeLines(stdout, lines); err != nil {
+			return err
+		}
+		if quit {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not parse input: %w", err)
+	}
+	return nil
+}
+
+// replSession is the state of the REPL.
+type replSession struct {
+	rowIOGateway adapter.RowIOGateway
+	writeFile    func(path string, content []byte) error
+	// The results entered so far, in order, including any header rows.
+	rows []string
+	// Where each of rows came from, see adapter.Options.InputSources.
+	sources []string
+	rowOpts adapter.Options
+	// The number of lines read from STDIN so far.
+	lineNumber int
+}
+
+// Returns the lines to show, and true if the REPL should quit. Errors are
+// shown rather than returned, so that entry can carry on.
+func (rs *replSession) handleLine(line string) ([]string, bool) {
+	rs.lineNumber++
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		return nil, false
+	}
+	if !strings.HasPrefix(trimmed, replCommandPrefix) {
+		return rs.addRow(line), false
+	}
+
+	name, arg, _ := strings.Cut(trimmed, " ")
+	arg = strings.TrimSpace(arg)
+	switch strings.ToLower(name) {
+	case replTable:
+		return rs.table(), false
+	case replUndo:
+		return rs.undo(), false
+	case replSave:
+		return rs.save(arg), false
+	case replHelp:
+		return []string{replHelpText}, false
+	case replQuit:
+		return nil, true
+	default:
+		return rs.errorLines(fmt.Errorf("unknown command %s, enter %s for help", name, replHelp)), false
+	}
+}
+
+func (rs *replSession) addRow(row string) []string {
+	rows := append(rs.rows[:len(rs.rows):len(rs.rows)], row)
+	sources := append(rs.sources[:len(rs.sources):len(rs.sources)], fmt.Sprintf("%s:%d", stdinName, rs.lineNumber))
+	if err := rs.rowIOGateway.ValidateInput(rows, rs.withSources(sources)); err != nil {
+		return rs.errorLines(err)
+	}
+	rs.rows, rs.sources = rows, sources
+	return nil
+}
+
+func (rs *replSession) withSources(sources []string) adapter.Options {
+	opts := rs.rowOpts
+	opts.InputSources = sources
+	return opts
+}
+
+func (rs *replSession) table() []string {
+	outputRows, err := rs.rowIOGateway.CalculateRankings(rs.rows, rs.withSources(rs.sources))
+	if err != nil {
+		return rs.errorLines(err)
+	}
+	return outputRows
+}
+
+func (rs *replSession) undo() []string {
+	if len(rs.rows) == 0 {
+		return []string{"nothing to undo"}
+	}
+	last := rs.rows[len(rs.rows)-1]
+	rs.rows = rs.rows[:len(rs.rows)-1]
+	rs.sources = rs.sources[:len(rs.sources)-1]
+	return []string{fmt.Sprintf("removed: %s", last)}
+}
+
+func (rs *replSession) save(file string) []string {
+	if file == "" {
+		return rs.errorLines(fmt.Errorf("expected a file to save to, e.g. %s results.txt", replSave))
+	}
+
+	var content strings.Builder
+	for _, row := range rs.rows {
+		content.WriteString(row + "\n")
+	}
+	if err := rs.writeFile(file, []byte(content.String())); err != nil {
+		return rs.errorLines(fmt.Errorf("could not save results: %w", err))
+	}
+	noun := "rows"
+	if len(rs.rows) == 1 {
+		noun = "row"
+	}
+	return []string{fmt.Sprintf("saved %d %s to %s", len(rs.rows), noun, file)}
+}
+
+func (rs *replSession) errorLines(err error) []string {
+	return []string{fmt.Sprintf("error: %s", err)}
+}
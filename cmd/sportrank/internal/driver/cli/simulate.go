@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"flag"
+
+	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/adapter"
+)
+
+// The simulate command estimates the probabilities of each team finishing in
+// each position, by simulating the remaining fixtures many times.
+func (ei *EngineImpl) simulateCommand() command {
+	var simOpts adapter.SimulationOptions
+	return command{
+		name: "simulate",
+		defineFlags: func(flagSet *flag.FlagSet, rowOpts *adapter.Options, files *fileArgs) {
+			flagSet.StringVar(&files.Fixtures, "fixtures", "",
+				"File of remaining fixtures, one \"<TeamA>, <TeamB>\" per line, or - for STDIN.")
+			flagSet.IntVar(&simOpts.Seasons, "seasons", 10000, "How many seasons to simulate.")
+			flagSet.Int64Var(&simOpts.Seed, "seed", 1, "Seed for the simulation. The same seed gives the same results.")
+			flagSet.BoolVar(&simOpts.HomeAdvantage, "home-advantage", false,
+				"Treat the first team of each game and fixture as the home team.")
+		},
+		execute: func(inputRows []string, rowOpts adapter.Options, rows fileRows) ([]string, error) {
+			simOpts.FixtureRows = rows.Fixtures
+			return ei.rowIOGateway.SimulateSeasons(inputRows, rowOpts, simOpts)
+		},
+	}
+}
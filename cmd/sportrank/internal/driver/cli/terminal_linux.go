@@ -0,0 +1,60 @@
+//go:build linux
+
+package cli
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// Only a terminal has terminal attributes, unlike other character devices
+// such as /dev/null.
+func (ei *EngineImpl) isTerminalFile(f *os.File) bool {
+	var termios syscall.Termios
+	return ei.ioctl(f.Fd(), syscall.TCGETS, unsafe.Pointer(&termios)) == nil
+}
+
+// Puts the terminal into raw mode, so keys are read as they are pressed,
+// without echo. The returned func restores the previous mode.
+func (ei *EngineImpl) enableRawMode(terminal *os.File) (func() error, error) {
+	fd := terminal.Fd()
+	var original syscall.Termios
+	if err := ei.ioctl(fd, syscall.TCGETS, unsafe.Pointer(&original)); err != nil {
+		return nil, err
+	}
+
+	raw := original
+	raw.Iflag &^= syscall.BRKINT | syscall.ICRNL | syscall.INPCK | syscall.ISTRIP | syscall.IXON
+	raw.Oflag &^= syscall.OPOST
+	raw.Cflag |= syscall.CS8
+	raw.Lflag &^= syscall.ECHO | syscall.ICANON | syscall.IEXTEN | syscall.ISIG
+	// Reads wait for at least one byte.
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	if err := ei.ioctl(fd, syscall.TCSETS, unsafe.Pointer(&raw)); err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		return ei.ioctl(fd, syscall.TCSETS, unsafe.Pointer(&original))
+	}, nil
+}
+
+// The width and height of the terminal, in characters.
+func (ei *EngineImpl) terminalSize(terminal *os.File) (int, int, error) {
+	var size struct {
+		rows, cols, xPixels, yPixels uint16
+	}
+	if err := ei.ioctl(terminal.Fd(), syscall.TIOCGWINSZ, unsafe.Pointer(&size)); err != nil {
+		return 0, 0, err
+	}
+	return int(size.cols), int(size.rows), nil
+}
+
+func (ei *EngineImpl) ioctl(fd uintptr, request uintptr, arg unsafe.Pointer) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, request, uintptr(arg)); errno != 0 {
+		return errno
+	}
+	return nil
+}
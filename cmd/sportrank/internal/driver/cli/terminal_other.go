@@ -0,0 +1,28 @@
+//go:build !linux
+
+package cli
+
+import (
+	"errors"
+	"os"
+)
+
+var errUnsupportedTerminal = errors.New("interactive terminals are only supported on Linux")
+
+// Character devices are taken to be terminals, though some, e.g. the null
+// device, are not.
+func (ei *EngineImpl) isTerminalFile(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func (ei *EngineImpl) enableRawMode(terminal *os.File) (func() error, error) {
+	return nil, errUnsupportedTerminal
+}
+
+func (ei *EngineImpl) terminalSize(terminal *os.File) (int, int, error) {
+	return 0, 0, errUnsupportedTerminal
+}
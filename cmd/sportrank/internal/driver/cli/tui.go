@@ -0,0 +1,468 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/adapter"
+)
+
+// The tui command is a full-screen view of the standings, which may be
+// sorted, re-ranked, and drilled into for a team's games.
+func (ei *EngineImpl) tuiCommand() command {
+	return command{
+		name: "tui",
+		defineFlags: func(flagSet *flag.FlagSet, rowOpts *adapter.Options, files *fileArgs) {
+			flagSet.StringVar(&rowOpts.RankBy, "rank-by", adapter.RankByNames()[0],
+				fmt.Sprintf("How to rank teams at first, one of: %s.", strings.Join(adapter.RankByNames(), ", ")))
+		},
+		interact: ei.runTUI,
+	}
+}
+
+// ANSI escape codes for full-screen output.
+const (
+	ansiAlternateScreen = "\x1b[?1049h"
+	ansiMainScreen      = "\x1b[?1049l"
+	ansiHideCursor      = "\x1b[?25l"
+	ansiShowCursor      = "\x1b[?25h"
+	ansiClearScreen     = "\x1b[H\x1b[2J"
+	ansiReverse         = "\x1b[7m"
+)
+
+// The size of the screen when it is not a terminal.
+const (
+	defaultScreenWidth  = 80
+	defaultScreenHeight = 24
+)
+
+func (ei *EngineImpl) runTUI(inputRows []string, rowOpts adapter.Options, stdin io.Reader, stdout io.Writer) error {
+	model := &tuiModel{
+		rowIOGateway: ei.rowIOGateway,
+		inputRows:    inputRows,
+		rowOpts:      rowOpts,
+		methods:      adapter.RankByNames(),
+		width:        defaultScreenWidth,
+		height:       defaultScreenHeight,
+	}
+	// Fail before taking over the screen if the results are malformed.
+	if err := model.selectMethod(rowOpts.RankBy); err != nil {
+		return err
+	}
+
+	// Keys are read as they are pressed on a terminal, or else as written,
+	// e.g. by a script.
+	if terminal, ok := stdin.(*os.File); ok && ei.isTerminal(terminal) {
+		restore, err := ei.enableRawMode(terminal)
+		if err != nil {
+			return fmt.Errorf("could not read keys from terminal: %w", err)
+		}
+		defer restore()
+	}
+	if terminal, ok := stdout.(*os.File); ok && ei.isTerminal(terminal) {
+		if width, height, err := ei.terminalSize(terminal); err == nil && width > 0 && height > 0 {
+			model.width, model.height = width, height
+		}
+	}
+
+	if _, err := io.WriteString(stdout, ansiAlternateScreen+ansiHideCursor); err != nil {
+		return fmt.Errorf("could not write to output: %w", err)
+	}
+	defer io.WriteString(stdout, ansiShowCursor+ansiMainScreen)
+
+	if err := ei.renderTUI(model, stdout); err != nil {
+		return err
+	}
+	buf := make([]byte, 64)
+	for {
+		n, err := stdin.Read(buf)
+		for _, key := range ei.parseKeys(buf[:n]) {
+			if quit := model.handleKey(key); quit {
+				return nil
+			}
+			if err := ei.renderTUI(model, stdout); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not read keys: %w", err)
+		}
+	}
+}
+
+func (ei *EngineImpl) renderTUI(model *tuiModel, stdout io.Writer) error {
+	// Raw terminals do not return the carriage on a line feed.
+	if _, err := io.WriteString(stdout, ansiClearScreen+strings.Join(model.view(), "\r\n")); err != nil {
+		return fmt.Errorf("could not write to output: %w", err)
+	}
+	return nil
+}
+
+// --- Key related ---
+
+// Keys which may be pressed, other than letters.
+const (
+	keyUp    = "up"
+	keyDown  = "down"
+	keyEnter = "enter"
+	keyBack  = "back"
+	keyQuit  = "quit"
+)
+
+// Letters which stand in for the above, as in vi and less.
+var letterKeys = map[string]string{
+	"k": keyUp,
+	"j": keyDown,
+	"l": keyEnter,
+	"h": keyBack,
+	"q": keyQuit,
+}
+
+// A terminal may give several keys at once, e.g. an arrow key's escape
+// sequence, or keys written by a script.
+func (ei *EngineImpl) parseKeys(chunk []byte) []string {
+	var keys []string
+	for len(chunk) > 0 {
+		switch {
+		case len(chunk) >= 3 && chunk[0] == '\x1b' && chunk[1] == '[':
+			switch chunk[2] {
+			case 'A':
+				keys = append(keys, keyUp)
+			case 'B':
+				keys = append(keys, keyDown)
+			case 'C':
+				keys = append(keys, keyEnter)
+			case 'D':
+				keys = append(keys, keyBack)
+			}
+			chunk = chunk[3:]
+			continue
+		case chunk[0] == '\x1b', chunk[0] == '\x7f', chunk[0] == '\b':
+			keys = append(keys, keyBack)
+		case chunk[0] == '\r', chunk[0] == '\n':
+			keys = append(keys, keyEnter)
+		// Ctrl-C, which does not interrupt in raw mode.
+		case chunk[0] == '\x03':
+			keys = append(keys, keyQuit)
+		default:
+			r, size := utf8.DecodeRune(chunk)
+			key := string(r)
+			if named, ok := letterKeys[key]; ok {
+				key = named
+			}
+			keys = append(keys, key)
+			chunk = chunk[size:]
+			continue
+		}
+		chunk = chunk[1:]
+	}
+	return keys
+}
+
+// --- Model related ---
+
+// tuiModel is the state of the TUI. Rankings and games come from the
+// RowIOGateway, as for the other commands.
+type tuiModel struct {
+	rowIOGateway adapter.RowIOGateway
+	inputRows    []string
+	rowOpts      adapter.Options
+	width        int
+	height       int
+
+	// The ranking methods which may be cycled through, and the current one.
+	methods []string
+	method  int
+	// The standings, in rank order, and the order they are shown in.
+	header []string
+	rows   [][]string
+	sorted [][]string
+	// Sorting by the rank column ascending is rank order.
+	sortColumn int
+	descending bool
+	selected   int
+	offset     int
+
+	// The team whose games are shown, or empty for the standings.
+	team  string
+	games []string
+	// An error to show, e.g. from the last key.
+	status string
+}
+
+// The team column is the same for every ranking method.
+const tuiTeamColumn = 1
+
+const (
+	tuiStandingsHelp = "↑/↓ move  enter games  s sort  r reverse  m method  q quit"
+	tuiGamesHelp     = "↑/↓ scroll  esc back  q quit"
+)
+
+// Returns true if the TUI should quit.
+func (tm *tuiModel) handleKey(key string) bool {
+	tm.status = ""
+	if key == keyQuit {
+		return true
+	}
+
+	if tm.team != "" {
+		switch key {
+		case keyUp:
+			tm.scrollTo(tm.offset-1, len(tm.games))
+		case keyDown:
+			tm.scrollTo(tm.offset+1, len(tm.games))
+		case keyBack:
+			tm.team, tm.games, tm.offset = "", nil, 0
+			tm.moveTo(tm.selected)
+		}
+		return false
+	}
+
+	switch key {
+	case keyUp:
+		tm.moveTo(tm.selected - 1)
+	case keyDown:
+		tm.moveTo(tm.selected + 1)
+	case keyEnter:
+		tm.showGames()
+	case "s":
+		tm.sortBy((tm.sortColumn + 1) % len(tm.header))
+	case "r":
+		tm.descending = !tm.descending
+		tm.sort()
+	case "m":
+		if err := tm.selectMethod(tm.methods[(tm.method+1)%len(tm.methods)]); err != nil {
+			tm.status = err.Error()
+		}
+	}
+	return false
+}
+
+func (tm *tuiModel) selectMethod(rankBy string) error {
+	cleaned := strings.ToLower(strings.TrimSpace(rankBy))
+	method := -1
+	for i, name := range tm.methods {
+		if name == cleaned || (cleaned == "" && i == 0) {
+			method = i
+		}
+	}
+	if method < 0 {
+		return fmt.Errorf("%s - %w, expected one of: %s",
+			rankBy, adapter.ErrUnknownRankBy, strings.Join(tm.methods, ", "))
+	}
+
+	opts := tm.rowOpts
+	opts.RankBy = tm.methods[method]
+	table, err := tm.rowIOGateway.TabulateRankings(tm.inputRows, opts)
+	if err != nil {
+		return err
+	}
+
+	tm.method = method
+	tm.header, tm.rows = table.Columns, table.Cells
+	tm.sortColumn, tm.descending = 0, false
+	tm.sort()
+	return nil
+}
+
+// Numeric columns other than the rank sort from highest, and others from A.
+func (tm *tuiModel) sortBy(column int) {
+	tm.sortColumn = column
+	_, numeric := tm.columnNumbers(column)
+	tm.descending = numeric && column != 0
+	tm.sort()
+}
+
+// The selected team stays selected, wherever it is sorted to.
+func (tm *tuiModel) sort() {
+	team := ""
+	if tm.selected < len(tm.sorted) {
+		team = tm.sorted[tm.selected][tuiTeamColumn]
+	}
+
+	numbers, numeric := tm.columnNumbers(tm.sortColumn)
+	order := make([]int, len(tm.rows))
+	for i := range order {
+		order[i] = i
+	}
+
+	// Stable, so level rows stay in rank order either way.
+	sort.SliceStable(order, func(i int, j int) bool {
+		a, b := order[i], order[j]
+		if tm.descending {
+			a, b = b, a
+		}
+		if numeric {
+			return numbers[a] < numbers[b]
+		}
+		return tm.rows[a][tm.sortColumn] < tm.rows[b][tm.sortColumn]
+	})
+
+	selected := tm.selected
+	tm.sorted = make([][]string, len(order))
+	for i, row := range order {
+		tm.sorted[i] = tm.rows[row]
+		if team != "" && tm.sorted[i][tuiTeamColumn] == team {
+			selected = i
+		}
+	}
+	tm.moveTo(selected)
+}
+
+// The column's numbers, by row in rank order, or false if any cell is not a
+// number, e.g. a team's name.
+func (tm *tuiModel) columnNumbers(column int) ([]float64, bool) {
+	numbers := make([]float64, len(tm.rows))
+	for i, row := range tm.rows {
+		if column >= len(row) {
+			return nil, false
+		}
+		number, err := strconv.ParseFloat(strings.TrimSuffix(row[column], "%"), 64)
+		if err != nil {
+			return nil, false
+		}
+		numbers[i] = number
+	}
+	return numbers, true
+}
+
+func (tm *tuiModel) showGames() {
+	if len(tm.sorted) == 0 {
+		return
+	}
+	team := tm.sorted[tm.selected][tuiTeamColumn]
+	games, err := tm.rowIOGateway.ListTeamGames(tm.inputRows, tm.rowOpts, team)
+	if err != nil {
+		tm.status = err.Error()
+		return
+	}
+	tm.team, tm.games, tm.offset = team, games, 0
+}
+
+// Rows which fit on the screen, below the title and header, and above the
+// help.
+func (tm *tuiModel) visibleRows() int {
+	if visible := tm.height - 5; visible > 1 {
+		return visible
+	}
+	return 1
+}
+
+// Select a row, scrolling to keep it on the screen.
+func (tm *tuiModel) moveTo(selected int) {
+	if selected >= len(tm.sorted) {
+		selected = len(tm.sorted) - 1
+	}
+	if selected < 0 {
+		selected = 0
+	}
+	tm.selected = selected
+	if tm.selected < tm.offset {
+		tm.offset = tm.selected
+	}
+	if visible := tm.visibleRows(); tm.selected >= tm.offset+visible {
+		tm.offset = tm.selected - visible + 1
+	}
+}
+
+func (tm *tuiModel) scrollTo(offset int, count int) {
+	if maxOffset := count - tm.visibleRows(); offset > maxOffset {
+		offset = maxOffset
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	tm.offset = offset
+}
+
+// The lines of the screen.
+func (tm *tuiModel) view() []string {
+	var lines []string
+	if tm.team != "" {
+		lines = append(lines, fmt.Sprintf("%s - games", tm.team), "")
+		end := minInt(tm.offset+tm.visibleRows(), len(tm.games))
+		lines = append(lines, tm.games[tm.offset:end]...)
+		lines = append(lines, "", tuiGamesHelp)
+	} else {
+		direction := "ascending"
+		if tm.descending {
+			direction = "descending"
+		}
+		lines = append(lines, fmt.Sprintf("Ranked by %s, sorted by %s %s",
+			tm.methods[tm.method], tm.header[tm.sortColumn], direction), "")
+		lines = append(lines, tm.viewTable()...)
+		lines = append(lines, "", tuiStandingsHelp)
+	}
+	if tm.status != "" {
+		lines = append(lines, tm.status)
+	}
+
+	for i, line := range lines {
+		lines[i] = tm.truncate(line)
+	}
+	if tm.team == "" && len(tm.sorted) > 0 {
+		// Highlight after truncating, so the escape codes are kept whole.
+		line := 3 + tm.selected - tm.offset
+		lines[line] = ansiReverse + lines[line] + ansiReset
+	}
+	return lines
+}
+
+// The header, and the visible rows, with aligned columns.
+func (tm *tuiModel) viewTable() []string {
+	widths := make([]int, len(tm.header))
+	numeric := make([]bool, len(tm.header))
+	for i, header := range tm.header {
+		widths[i] = utf8.RuneCountInString(header)
+		_, numeric[i] = tm.columnNumbers(i)
+		for _, row := range tm.rows {
+			if width := utf8.RuneCountInString(row[i]); width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+
+	end := minInt(tm.offset+tm.visibleRows(), len(tm.sorted))
+	lines := []string{tm.viewRow(tm.header, widths, numeric)}
+	for _, row := range tm.sorted[tm.offset:end] {
+		lines = append(lines, tm.viewRow(row, widths, numeric))
+	}
+	return lines
+}
+
+func (tm *tuiModel) viewRow(cells []string, widths []int, numeric []bool) string {
+	padded := make([]string, len(cells))
+	for i, cell := range cells {
+		padding := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+		if numeric[i] {
+			padded[i] = padding + cell
+		} else {
+			padded[i] = cell + padding
+		}
+	}
+	return strings.TrimRight(strings.Join(padded, "  "), " ")
+}
+
+func (tm *tuiModel) truncate(line string) string {
+	if utf8.RuneCountInString(line) <= tm.width {
+		return line
+	}
+	return string([]rune(line)[:tm.width])
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
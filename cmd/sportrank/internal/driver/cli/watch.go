@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// watchArgs re-run a command whenever its input files change.
+type watchArgs struct {
+	Enabled  bool
+	Interval time.Duration
+}
+
+const defaultWatchInterval = time.Second
+
+func (ei *EngineImpl) defineWatchFlags(flagSet *flag.FlagSet, watch *watchArgs) {
+	flagSet.BoolVar(&watch.Enabled, "watch", false,
+		"Keep running, and rewrite the output whenever an input file changes, until interrupted.")
+	watch.Interval = defaultWatchInterval
+	flagSet.Func("watch-interval",
+		"How often to check input files for changes, with --watch. (default 1s)",
+		func(arg string) error {
+			interval, err := time.ParseDuration(arg)
+			if err != nil {
+				return err
+			}
+			if interval <= 0 {
+				return errors.New("expected a positive duration")
+			}
+			watch.Interval = interval
+			return nil
+		})
+}
+
+// The input files given, which are watched for changes. Glob patterns are
+// expanded afresh each time, so that new files which match are noticed.
+func (ei *EngineImpl) inputArgs(files fileArgs) []string {
+	// Patterns which match nothing yet are left out.
+	args, _ := ei.expandInputArgs(files.Inputs)
+	for _, arg := range []string{
+		files.Adjustments, files.Rules, files.Fixtures, files.Hypothetical, files.Previous, files.Template,
+	} {
+		if cleaned := strings.TrimSpace(arg); cleaned != "" {
+			args = append(args, cleaned)
+		}
+	}
+	return args
+}
+
+func (ei *EngineImpl) readsStdin(files fileArgs) bool {
+	for _, arg := range ei.inputArgs(files) {
+		if arg == "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// Re-run the command whenever an input file changes, until interrupted.
+// Errors, e.g. from a half-finished edit, are reported rather than stopping.
+func (ei *EngineImpl) watch(cmd command, opts options, stdin io.Reader) int {
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupted)
+
+	ticker := time.NewTicker(opts.Watch.Interval)
+	defer ticker.Stop()
+
+	var last []fileState
+	for {
+		// Globs are expanded each time, so that new files which match count.
+		if current := ei.statFiles(ei.inputArgs(opts.Files)); !ei.sameFileStates(current, last) {
+			last = current
+			if err := ei.refresh(cmd, opts, stdin); err != nil {
+				ei.fail(err)
+			}
+		}
+
+		select {
+		case <-interrupted:
+			return SuccessCode
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reopen the inputs, and re-run the command.
+func (ei *EngineImpl) refresh(cmd command, opts options, stdin io.Reader) error {
+	opts.Closers = nil
+	if err := ei.openInputs(&opts, stdin); err != nil {
+		return err
+	}
+	defer ei.closeAll(opts.Closers)
+	return ei.executeToOutput(cmd, opts, stdin)
+}
+
+// fileState is what is checked for changes to a file. A missing file, e.g.
+// mid-save, is a state of its own.
+type fileState struct {
+	name    string
+	exists  bool
+	modTime int64
+	size    int64
+}
+
+func (ei *EngineImpl) statFiles(args []string) []fileState {
+	states := make([]fileState, len(args))
+	for i, arg := range args {
+		states[i] = fileState{name: arg}
+		if info, err := os.Stat(arg); err == nil {
+			states[i] = fileState{name: arg, exists: true, modTime: info.ModTime().UnixNano(), size: info.Size()}
+		}
+	}
+	return states
+}
+
+// Before the first check, last is nil, which is never the same.
+func (ei *EngineImpl) sameFileStates(current []fileState, last []fileState) bool {
+	if last == nil || len(current) != len(last) {
+		return false
+	}
+	for i := range current {
+		if current[i] != last[i] {
+			return false
+		}
+	}
+	return true
+}
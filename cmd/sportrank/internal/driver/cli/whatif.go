@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"flag"
+
+	"github.com/liampulles/ranking-cli/cmd/sportrank/internal/adapter"
+)
+
+// The whatif command shows how the table would change, were some hypothetical
+// games to be played, e.g. "what if Lions beat Snakes 2-0 next week?".
+func (ei *EngineImpl) whatIfCommand() command {
+	return command{
+		name: "whatif",
+		defineFlags: func(flagSet *flag.FlagSet, rowOpts *adapter.Options, files *fileArgs) {
+			flagSet.StringVar(&files.Hypothetical, "hypothetical", "",
+				"File of hypothetical results, in the same form as the input, or - for STDIN.")
+		},
+		execute: func(inputRows []string, rowOpts adapter.Options, rows fileRows) ([]string, error) {
+			return ei.rowIOGateway.EvaluateWhatIf(inputRows, rowOpts, adapter.WhatIfOptions{
+				HypotheticalRows:    rows.Hypothetical,
+				HypotheticalSources: rows.HypotheticalSources,
+			})
+		},
+	}
+}
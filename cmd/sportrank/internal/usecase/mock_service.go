@@ -12,13 +12,91 @@ type MockService struct {
 	mock.Mock
 }
 
-// CalculateRankings provides a mock function with given fields: gameResults
-func (_m *MockService) CalculateRankings(gameResults []league.GameResult) []league.Ranking {
-	ret := _m.Called(gameResults)
+// CalculateBradleyTerryRatings provides a mock function with given fields: gameResults, opts
+func (_m *MockService) CalculateBradleyTerryRatings(gameResults []league.GameResult, opts league.BradleyTerryOptions) league.BradleyTerryModel {
+	ret := _m.Called(gameResults, opts)
+
+	var r0 league.BradleyTerryModel
+	if rf, ok := ret.Get(0).(func([]league.GameResult, league.BradleyTerryOptions) league.BradleyTerryModel); ok {
+		r0 = rf(gameResults, opts)
+	} else {
+		r0 = ret.Get(0).(league.BradleyTerryModel)
+	}
+
+	return r0
+}
+
+// CalculateColleyRatings provides a mock function with given fields: gameResults, opts
+func (_m *MockService) CalculateColleyRatings(gameResults []league.GameResult, opts league.MatrixOptions) []league.MatrixRating {
+	ret := _m.Called(gameResults, opts)
+
+	var r0 []league.MatrixRating
+	if rf, ok := ret.Get(0).(func([]league.GameResult, league.MatrixOptions) []league.MatrixRating); ok {
+		r0 = rf(gameResults, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]league.MatrixRating)
+		}
+	}
+
+	return r0
+}
+
+// CalculateGlicko2Ratings provides a mock function with given fields: gameResults, opts
+func (_m *MockService) CalculateGlicko2Ratings(gameResults []league.GameResult, opts league.Glicko2Options) []league.Glicko2Rating {
+	ret := _m.Called(gameResults, opts)
+
+	var r0 []league.Glicko2Rating
+	if rf, ok := ret.Get(0).(func([]league.GameResult, league.Glicko2Options) []league.Glicko2Rating); ok {
+		r0 = rf(gameResults, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]league.Glicko2Rating)
+		}
+	}
+
+	return r0
+}
+
+// CalculateMasseyRatings provides a mock function with given fields: gameResults, opts
+func (_m *MockService) CalculateMasseyRatings(gameResults []league.GameResult, opts league.MatrixOptions) []league.MatrixRating {
+	ret := _m.Called(gameResults, opts)
+
+	var r0 []league.MatrixRating
+	if rf, ok := ret.Get(0).(func([]league.GameResult, league.MatrixOptions) []league.MatrixRating); ok {
+		r0 = rf(gameResults, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]league.MatrixRating)
+		}
+	}
+
+	return r0
+}
+
+// CalculatePositionRanges provides a mock function with given fields: played, fixtures, opts
+func (_m *MockService) CalculatePositionRanges(played []league.GameResult, fixtures []league.Fixture, opts league.Options) map[string]league.PositionRange {
+	ret := _m.Called(played, fixtures, opts)
+
+	var r0 map[string]league.PositionRange
+	if rf, ok := ret.Get(0).(func([]league.GameResult, []league.Fixture, league.Options) map[string]league.PositionRange); ok {
+		r0 = rf(played, fixtures, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]league.PositionRange)
+		}
+	}
+
+	return r0
+}
+
+// CalculateRankings provides a mock function with given fields: gameResults, opts
+func (_m *MockService) CalculateRankings(gameResults []league.GameResult, opts league.Options) []league.Ranking {
+	ret := _m.Called(gameResults, opts)
 
 	var r0 []league.Ranking
-	if rf, ok := ret.Get(0).(func([]league.GameResult) []league.Ranking); ok {
-		r0 = rf(gameResults)
+	if rf, ok := ret.Get(0).(func([]league.GameResult, league.Options) []league.Ranking); ok {
+		r0 = rf(gameResults, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]league.Ranking)
@@ -28,6 +106,84 @@ func (_m *MockService) CalculateRankings(gameResults []league.GameResult) []leag
 	return r0
 }
 
+// CalculateStrengthOfSchedule provides a mock function with given fields: gameResults, teamValues
+func (_m *MockService) CalculateStrengthOfSchedule(gameResults []league.GameResult, teamValues map[string]float64) map[string]league.StrengthOfSchedule {
+	ret := _m.Called(gameResults, teamValues)
+
+	var r0 map[string]league.StrengthOfSchedule
+	if rf, ok := ret.Get(0).(func([]league.GameResult, map[string]float64) map[string]league.StrengthOfSchedule); ok {
+		r0 = rf(gameResults, teamValues)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]league.StrengthOfSchedule)
+		}
+	}
+
+	return r0
+}
+
+// DiffRankings provides a mock function with given fields: before, after
+func (_m *MockService) DiffRankings(before []league.Ranking, after []league.Ranking) []league.RankingChange {
+	ret := _m.Called(before, after)
+
+	var r0 []league.RankingChange
+	if rf, ok := ret.Get(0).(func([]league.Ranking, []league.Ranking) []league.RankingChange); ok {
+		r0 = rf(before, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]league.RankingChange)
+		}
+	}
+
+	return r0
+}
+
+// FitPoissonModel provides a mock function with given fields: gameResults, opts
+func (_m *MockService) FitPoissonModel(gameResults []league.GameResult, opts league.PoissonOptions) league.PoissonModel {
+	ret := _m.Called(gameResults, opts)
+
+	var r0 league.PoissonModel
+	if rf, ok := ret.Get(0).(func([]league.GameResult, league.PoissonOptions) league.PoissonModel); ok {
+		r0 = rf(gameResults, opts)
+	} else {
+		r0 = ret.Get(0).(league.PoissonModel)
+	}
+
+	return r0
+}
+
+// SimulateSeasons provides a mock function with given fields: played, fixtures, opts
+func (_m *MockService) SimulateSeasons(played []league.GameResult, fixtures []league.Fixture, opts league.SimulationOptions) []league.PositionProbabilities {
+	ret := _m.Called(played, fixtures, opts)
+
+	var r0 []league.PositionProbabilities
+	if rf, ok := ret.Get(0).(func([]league.GameResult, []league.Fixture, league.SimulationOptions) []league.PositionProbabilities); ok {
+		r0 = rf(played, fixtures, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]league.PositionProbabilities)
+		}
+	}
+
+	return r0
+}
+
+// TeamGames provides a mock function with given fields: gameResults, team
+func (_m *MockService) TeamGames(gameResults []league.GameResult, team string) []league.GameResult {
+	ret := _m.Called(gameResults, team)
+
+	var r0 []league.GameResult
+	if rf, ok := ret.Get(0).(func([]league.GameResult, string) []league.GameResult); ok {
+		r0 = rf(gameResults, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]league.GameResult)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockService interface {
 	mock.TestingT
 	Cleanup(func())
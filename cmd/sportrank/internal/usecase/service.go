@@ -4,7 +4,28 @@ import "github.com/liampulles/ranking-cli/pkg/league"
 
 // Service provides usecases of the system, i.e. the real application logic.
 type Service interface {
-	CalculateRankings(gameResults []league.GameResult) []league.Ranking
+	CalculateRankings(gameResults []league.GameResult, opts league.Options) []league.Ranking
+	CalculateGlicko2Ratings(gameResults []league.GameResult, opts league.Glicko2Options) []league.Glicko2Rating
+	CalculateBradleyTerryRatings(gameResults []league.GameResult, opts league.BradleyTerryOptions) league.BradleyTerryModel
+	CalculateColleyRatings(gameResults []league.GameResult, opts league.MatrixOptions) []league.MatrixRating
+	CalculateMasseyRatings(gameResults []league.GameResult, opts league.MatrixOptions) []league.MatrixRating
+	CalculateStrengthOfSchedule(
+		gameResults []league.GameResult,
+		teamValues map[string]float64,
+	) map[string]league.StrengthOfSchedule
+	SimulateSeasons(
+		played []league.GameResult,
+		fixtures []league.Fixture,
+		opts league.SimulationOptions,
+	) []league.PositionProbabilities
+	CalculatePositionRanges(
+		played []league.GameResult,
+		fixtures []league.Fixture,
+		opts league.Options,
+	) map[string]league.PositionRange
+	FitPoissonModel(gameResults []league.GameResult, opts league.PoissonOptions) league.PoissonModel
+	DiffRankings(before []league.Ranking, after []league.Ranking) []league.RankingChange
+	TeamGames(gameResults []league.GameResult, team string) []league.GameResult
 }
 
 type ServiceImpl struct{}
@@ -15,7 +36,83 @@ func NewServiceImpl() *ServiceImpl {
 	return &ServiceImpl{}
 }
 
-func (si *ServiceImpl) CalculateRankings(gameResults []league.GameResult) []league.Ranking {
+func (si *ServiceImpl) CalculateRankings(gameResults []league.GameResult, opts league.Options) []league.Ranking {
 	// Delegate to league package.
-	return league.CalculateRankings(gameResults)
+	return league.CalculateRankingsWithOptions(gameResults, opts)
+}
+
+func (si *ServiceImpl) CalculateGlicko2Ratings(
+	gameResults []league.GameResult,
+	opts league.Glicko2Options,
+) []league.Glicko2Rating {
+	// Delegate to league package.
+	return league.CalculateGlicko2Ratings(gameResults, opts)
+}
+
+func (si *ServiceImpl) CalculateBradleyTerryRatings(
+	gameResults []league.GameResult,
+	opts league.BradleyTerryOptions,
+) league.BradleyTerryModel {
+	// Delegate to league package.
+	return league.CalculateBradleyTerryRatings(gameResults, opts)
+}
+
+func (si *ServiceImpl) CalculateColleyRatings(
+	gameResults []league.GameResult,
+	opts league.MatrixOptions,
+) []league.MatrixRating {
+	// Delegate to league package.
+	return league.CalculateColleyRatings(gameResults, opts)
+}
+
+func (si *ServiceImpl) CalculateMasseyRatings(
+	gameResults []league.GameResult,
+	opts league.MatrixOptions,
+) []league.MatrixRating {
+	// Delegate to league package.
+	return league.CalculateMasseyRatings(gameResults, opts)
+}
+
+func (si *ServiceImpl) CalculateStrengthOfSchedule(
+	gameResults []league.GameResult,
+	teamValues map[string]float64,
+) map[string]league.StrengthOfSchedule {
+	// Delegate to league package.
+	return league.CalculateStrengthOfSchedule(gameResults, teamValues)
+}
+
+func (si *ServiceImpl) SimulateSeasons(
+	played []league.GameResult,
+	fixtures []league.Fixture,
+	opts league.SimulationOptions,
+) []league.PositionProbabilities {
+	// Delegate to league package.
+	return league.SimulateSeasons(played, fixtures, opts)
+}
+
+func (si *ServiceImpl) CalculatePositionRanges(
+	played []league.GameResult,
+	fixtures []league.Fixture,
+	opts league.Options,
+) map[string]league.PositionRange {
+	// Delegate to league package.
+	return league.CalculatePositionRanges(played, fixtures, opts)
+}
+
+func (si *ServiceImpl) FitPoissonModel(
+	gameResults []league.GameResult,
+	opts league.PoissonOptions,
+) league.PoissonModel {
+	// Delegate to league package.
+	return league.FitPoissonModel(gameResults, opts)
+}
+
+func (si *ServiceImpl) DiffRankings(before []league.Ranking, after []league.Ranking) []league.RankingChange {
+	// Delegate to league package.
+	return league.DiffRankings(before, after)
+}
+
+func (si *ServiceImpl) TeamGames(gameResults []league.GameResult, team string) []league.GameResult {
+	// Delegate to league package.
+	return league.TeamGames(gameResults, team)
 }
@@ -0,0 +1,291 @@
+package league
+
+import (
+	"math"
+	"sort"
+)
+
+// --- CalculateBradleyTerryRatings related ---
+
+// The Bradley-Terry model gives each team a strength p, such that team i beats
+// team j with probability p_i / (p_i + p_j). Davidson's extension allows for
+// draws with a draw parameter v, so that:
+//
+//	P(i beats j) = p_i / (p_i + p_j + v*sqrt(p_i*p_j))
+//	P(draw)      = v*sqrt(p_i*p_j) / (p_i + p_j + v*sqrt(p_i*p_j))
+//
+// See Davidson (1970), "On Extending the Bradley-Terry Model to Accommodate
+// Ties in Paired Comparison Experiments".
+
+// BradleyTerryOptions customise how Bradley-Terry strengths are estimated.
+type BradleyTerryOptions struct {
+	// Iteration stops once no team's log strength changes by more than this.
+	Tolerance float64
+	// Iteration stops after this many iterations, even if not converged.
+	MaxIterations int
+	// The number of virtual games each team plays against an average team,
+	// half won and half lost. Without these, a team which won (or lost) every
+	// game would have an infinite (or zero) strength. With a zero prior, such
+	// teams keep their initial strength of 1.
+	Prior float64
+	// How teams level on strength are ranked.
+	RankingStyle RankingStyle
+}
+
+// DefaultBradleyTerryOptions are suitable for most leagues.
+func DefaultBradleyTerryOptions() BradleyTerryOptions {
+	return BradleyTerryOptions{
+		Tolerance:     1e-9,
+		MaxIterations: 10000,
+		Prior:         1,
+	}
+}
+
+// BradleyTerryRating is a team's estimated strength.
+type BradleyTerryRating struct {
+	Rank uint
+	Team string
+	// Relative to an average team, which has a strength of 1. That is, the
+	// virtual team of the prior, or else the geometric mean of all teams.
+	Strength float64
+	// The probability of beating an average team.
+	WinProbability float64
+}
+
+// BradleyTerryModel is the result of fitting the Bradley-Terry model to game
+// results.
+type BradleyTerryModel struct {
+	// Ordered by strength.
+	Ratings []BradleyTerryRating
+	// Davidson's draw parameter. Zero if there were no draws.
+	DrawParameter float64
+	// How many iterations were performed, and whether they converged within
+	// the tolerance.
+	Iterations int
+	Converged  bool
+}
+
+// Probabilities gives the implied probabilities of teamA winning, drawing
+// with, or losing to teamB. ok is false if either team is unknown.
+func (m BradleyTerryModel) Probabilities(teamA string, teamB string) (win float64, draw float64, loss float64, ok bool) {
+	strengthA, okA := m.strength(teamA)
+	strengthB, okB := m.strength(teamB)
+	if !okA || !okB {
+		return 0, 0, 0, false
+	}
+	win, draw, loss = davidsonProbabilities(strengthA, strengthB, m.DrawParameter)
+	return win, draw, loss, true
+}
+
+func (m BradleyTerryModel) strength(team string) (float64, bool) {
+	for _, rating := range m.Ratings {
+		if rating.Team == team {
+			return rating.Strength, true
+		}
+	}
+	return 0, false
+}
+
+func davidsonProbabilities(strengthA float64, strengthB float64, drawParameter float64) (win float64, draw float64, loss float64) {
+	tie := drawParameter * math.Sqrt(strengthA*strengthB)
+	total := strengthA + strengthB + tie
+	return strengthA / total, tie / total, strengthB / total
+}
+
+// Estimate the Bradley-Terry strengths of all the teams in a league given game
+// results, by maximum likelihood. Abandoned games are ignored, and awarded
+// games count as a win and a loss. The result does not depend on the order of
+// game results.
+func CalculateBradleyTerryRatings(gameResults []GameResult, opts BradleyTerryOptions) BradleyTerryModel {
+	fit := newBradleyTerryFit(gameResults)
+	iterations, converged := fit.run(opts)
+
+	ratings := make([]BradleyTerryRating, len(fit.teams))
+	for i, team := range fit.teams {
+		win, _, _ := davidsonProbabilities(fit.strengths[i], 1, fit.drawParameter)
+		ratings[i] = BradleyTerryRating{
+			Team:           team,
+			Strength:       fit.strengths[i],
+			WinProbability: win,
+		}
+	}
+	rankBradleyTerryRatings(ratings, opts.RankingStyle)
+
+	return BradleyTerryModel{
+		Ratings:       ratings,
+		DrawParameter: fit.drawParameter,
+		Iterations:    iterations,
+		Converged:     converged,
+	}
+}
+
+type bradleyTerryFit struct {
+	// Sorted, so that iteration is deterministic.
+	teams []string
+	// Indexed as teams.
+	wins  []float64
+	draws []float64
+	// games[i][j] is the number of games between team i and team j.
+	games     [][]float64
+	drawCount float64
+
+	strengths     []float64
+	drawParameter float64
+}
+
+func newBradleyTerryFit(gameResults []GameResult) *bradleyTerryFit {
+	teamsToIndices := make(map[string]int)
+	for _, gameResult := range gameResults {
+		teamsToIndices[gameResult.TeamA] = 0
+		teamsToIndices[gameResult.TeamB] = 0
+	}
+	teams := make([]string, 0, len(teamsToIndices))
+	for team := range teamsToIndices {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+	for i, team := range teams {
+		teamsToIndices[team] = i
+	}
+
+	fit := &bradleyTerryFit{
+		teams:     teams,
+		wins:      make([]float64, len(teams)),
+		draws:     make([]float64, len(teams)),
+		games:     make([][]float64, len(teams)),
+		strengths: make([]float64, len(teams)),
+	}
+	for i := range teams {
+		fit.games[i] = make([]float64, len(teams))
+		fit.strengths[i] = 1
+	}
+
+	for _, gameResult := range gameResults {
+		if gameResult.Status == StatusAbandoned {
+			continue
+		}
+		a, b := teamsToIndices[gameResult.TeamA], teamsToIndices[gameResult.TeamB]
+		fit.games[a][b]++
+		fit.games[b][a]++
+
+		outcomeA, outcomeB := gameResult.Outcomes()
+		fit.record(a, outcomeA)
+		fit.record(b, outcomeB)
+		if outcomeA == OutcomeDraw {
+			fit.drawCount++
+		}
+	}
+	return fit
+}
+
+func (f *bradleyTerryFit) record(team int, outcome Outcome) {
+	switch outcome {
+	case OutcomeWin:
+		f.wins[team]++
+	case OutcomeDraw:
+		f.draws[team]++
+	}
+}
+
+// Uses the fixed point iterations given by Davidson, alternating between the
+// draw parameter and the strengths.
+func (f *bradleyTerryFit) run(opts BradleyTerryOptions) (iterations int, converged bool) {
+	for iterations < opts.MaxIterations {
+		iterations++
+		nextDrawParameter := f.nextDrawParameter()
+		maxChange := math.Abs(math.Log(nextDrawParameter) - math.Log(f.drawParameter))
+		if f.drawCount == 0 {
+			maxChange = 0
+		}
+		f.drawParameter = nextDrawParameter
+
+		next := f.nextStrengths(opts.Prior)
+		for i := range next {
+			maxChange = math.Max(maxChange, math.Abs(math.Log(next[i])-math.Log(f.strengths[i])))
+		}
+		f.strengths = next
+		if maxChange <= opts.Tolerance {
+			return iterations, true
+		}
+	}
+	return iterations, len(f.teams) == 0
+}
+
+func (f *bradleyTerryFit) nextStrengths(prior float64) []float64 {
+	next := make([]float64, len(f.strengths))
+	for i, strengthI := range f.strengths {
+		// The virtual games are against a team of strength 1, without draws.
+		numerator := f.wins[i] + f.draws[i]/2 + prior/2
+		denominator := prior / (strengthI + 1)
+		for j, strengthJ := range f.strengths {
+			if f.games[i][j] == 0 {
+				continue
+			}
+			root := math.Sqrt(strengthI * strengthJ)
+			total := strengthI + strengthJ + f.drawParameter*root
+			denominator += f.games[i][j] * (1 + f.drawParameter*math.Sqrt(strengthJ/strengthI)/2) / total
+		}
+
+		if numerator == 0 || denominator == 0 {
+			// Only possible without a prior, or without games at all.
+			next[i] = strengthI
+			continue
+		}
+		next[i] = numerator / denominator
+	}
+	if prior == 0 {
+		normaliseStrengths(next)
+	}
+	return next
+}
+
+func (f *bradleyTerryFit) nextDrawParameter() float64 {
+	if f.drawCount == 0 {
+		return 0
+	}
+	expected := 0.0
+	for i, strengthI := range f.strengths {
+		for j := i + 1; j < len(f.strengths); j++ {
+			if f.games[i][j] == 0 {
+				continue
+			}
+			strengthJ := f.strengths[j]
+			root := math.Sqrt(strengthI * strengthJ)
+			expected += f.games[i][j] * root / (strengthI + strengthJ + f.drawParameter*root)
+		}
+	}
+	return f.drawCount / expected
+}
+
+// Without a prior, strengths are only determined up to a common factor, so
+// they are scaled to have a geometric mean of 1.
+func normaliseStrengths(strengths []float64) {
+	if len(strengths) == 0 {
+		return
+	}
+	logSum := 0.0
+	for _, strength := range strengths {
+		logSum += math.Log(strength)
+	}
+	scale := math.Exp(-logSum / float64(len(strengths)))
+	for i := range strengths {
+		strengths[i] *= scale
+	}
+}
+
+func rankBradleyTerryRatings(ratings []BradleyTerryRating, style RankingStyle) {
+	// Sort by strength descending, then team name ascending
+	sort.Slice(ratings, func(i int, j int) bool {
+		a, b := ratings[i], ratings[j]
+		if a.Strength == b.Strength {
+			return a.Team < b.Team
+		}
+		return a.Strength > b.Strength
+	})
+
+	// Assign rank
+	ranks := assignRanks(len(ratings), func(i int) float64 { return ratings[i].Strength }, style)
+	for i := range ratings {
+		ratings[i].Rank = ranks[i]
+	}
+}
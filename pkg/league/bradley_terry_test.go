@@ -0,0 +1,188 @@
+package league_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateBradleyTerryRatings_GivenNoDraws_ShouldMatchWinRatio(t *testing.T) {
+	// Setup fixture
+	// -> Without a prior, the strengths of two teams are in the ratio of
+	//    their wins.
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 2, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Snakes", ScoreA: 1, TeamB: "Lions", ScoreB: 0},
+	}
+	optsFixture := league.DefaultBradleyTerryOptions()
+	optsFixture.Prior = 0
+
+	// Exercise SUT
+	actual := league.CalculateBradleyTerryRatings(gameResultsFixture, optsFixture)
+
+	// Verify results
+	assert.True(t, actual.Converged)
+	assert.Equal(t, 0.0, actual.DrawParameter)
+	assert.Equal(t, []string{"Lions", "Snakes"}, bradleyTerryTeamsOf(actual.Ratings))
+	assert.InDelta(t, math.Sqrt2, actual.Ratings[0].Strength, 1e-6)
+	assert.InDelta(t, 1/math.Sqrt2, actual.Ratings[1].Strength, 1e-6)
+
+	win, draw, loss, ok := actual.Probabilities("Lions", "Snakes")
+	assert.True(t, ok)
+	assert.InDelta(t, 2.0/3, win, 1e-6)
+	assert.Equal(t, 0.0, draw)
+	assert.InDelta(t, 1.0/3, loss, 1e-6)
+}
+
+func TestCalculateBradleyTerryRatings_GivenDraws_ShouldMatchFrequencies(t *testing.T) {
+	// Setup fixture
+	// -> With two teams, the maximum likelihood probabilities are the
+	//    observed frequencies: 2 wins, 1 draw and 1 loss for the Lions.
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Snakes", ScoreA: 0, TeamB: "Lions", ScoreB: 3},
+		{TeamA: "Lions", ScoreA: 2, TeamB: "Snakes", ScoreB: 2},
+		{TeamA: "Snakes", ScoreA: 1, TeamB: "Lions", ScoreB: 0},
+	}
+	optsFixture := league.DefaultBradleyTerryOptions()
+	optsFixture.Prior = 0
+
+	// Exercise SUT
+	actual := league.CalculateBradleyTerryRatings(gameResultsFixture, optsFixture)
+
+	// Verify results
+	assert.True(t, actual.Converged)
+	assert.InDelta(t, 1/math.Sqrt2, actual.DrawParameter, 1e-6)
+	assert.InDelta(t, 2, actual.Ratings[0].Strength/actual.Ratings[1].Strength, 1e-6)
+
+	win, draw, loss, ok := actual.Probabilities("Lions", "Snakes")
+	assert.True(t, ok)
+	assert.InDelta(t, 0.5, win, 1e-6)
+	assert.InDelta(t, 0.25, draw, 1e-6)
+	assert.InDelta(t, 0.25, loss, 1e-6)
+}
+
+func TestCalculateBradleyTerryRatings_GivenEvenRecords_ShouldRateEqually(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Lions", ScoreA: 0, TeamB: "Snakes", ScoreB: 1},
+	}
+
+	// Exercise SUT
+	actual := league.CalculateBradleyTerryRatings(gameResultsFixture, league.DefaultBradleyTerryOptions())
+
+	// Verify results
+	// -> One draw in three games between equal teams: v / (2 + v) = 1/3
+	assert.InDelta(t, 1, actual.DrawParameter, 1e-6)
+	for _, rating := range actual.Ratings {
+		assert.InDelta(t, 1, rating.Strength, 1e-6)
+		assert.InDelta(t, 1.0/3, rating.WinProbability, 1e-6)
+	}
+}
+
+func TestCalculateBradleyTerryRatings_GivenRankingStyle_ShouldRankLevelTeamsByIt(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 0, TeamB: "Snakes", ScoreB: 1},
+	}
+	optsFixture := league.DefaultBradleyTerryOptions()
+	optsFixture.RankingStyle = league.RankingStyleOrdinal
+
+	// Exercise SUT
+	standard := league.CalculateBradleyTerryRatings(gameResultsFixture, league.DefaultBradleyTerryOptions())
+	ordinal := league.CalculateBradleyTerryRatings(gameResultsFixture, optsFixture)
+
+	// Verify results
+	assert.Equal(t, []uint{1, 1}, []uint{standard.Ratings[0].Rank, standard.Ratings[1].Rank})
+	assert.Equal(t, []uint{1, 2}, []uint{ordinal.Ratings[0].Rank, ordinal.Ratings[1].Rank})
+}
+
+func TestCalculateBradleyTerryRatings_GivenUnbeatenTeam_ShouldUsePriorToStayFinite(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Snakes", ScoreA: 1, TeamB: "Tarantulas", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 2, TeamB: "Tarantulas", ScoreB: 0},
+		{TeamA: "Tarantulas", ScoreA: 1, TeamB: "Snakes", ScoreB: 2},
+		{TeamA: "Lions", ScoreA: 2, TeamB: "Grouches", ScoreB: 2, Status: league.StatusAbandoned},
+	}
+
+	// Exercise SUT
+	actual := league.CalculateBradleyTerryRatings(gameResultsFixture, league.DefaultBradleyTerryOptions())
+
+	// Verify results
+	assert.True(t, actual.Converged)
+	assert.Equal(t, []string{"Lions", "Snakes", "Grouches", "Tarantulas"}, bradleyTerryTeamsOf(actual.Ratings))
+	assert.False(t, math.IsInf(actual.Ratings[0].Strength, 1))
+	assert.Greater(t, actual.Ratings[0].Strength, 1.0)
+	assert.Less(t, actual.Ratings[3].Strength, 1.0)
+	// -> Grouches only played an abandoned game, so they are average.
+	assert.InDelta(t, 1, actual.Ratings[2].Strength, 1e-6)
+	assert.InDelta(t, 0.5, actual.Ratings[2].WinProbability, 1e-6)
+}
+
+func TestCalculateBradleyTerryRatings_ShouldNotDependOnOrder(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 3},
+		{TeamA: "Tarantulas", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 1},
+		{TeamA: "Tarantulas", ScoreA: 3, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Lions", ScoreA: 4, TeamB: "Grouches", ScoreB: 0},
+	}
+	reversedFixture := make([]league.GameResult, len(gameResultsFixture))
+	for i, gameResult := range gameResultsFixture {
+		reversedFixture[len(gameResultsFixture)-1-i] = gameResult
+	}
+
+	// Exercise SUT
+	actual := league.CalculateBradleyTerryRatings(gameResultsFixture, league.DefaultBradleyTerryOptions())
+	reversed := league.CalculateBradleyTerryRatings(reversedFixture, league.DefaultBradleyTerryOptions())
+
+	// Verify results
+	assert.Equal(t, actual, reversed)
+}
+
+func TestCalculateBradleyTerryRatings_GivenMaxIterations_ShouldStopEarly(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Snakes", ScoreA: 1, TeamB: "Tarantulas", ScoreB: 0},
+	}
+	optsFixture := league.DefaultBradleyTerryOptions()
+	optsFixture.MaxIterations = 2
+
+	// Exercise SUT
+	actual := league.CalculateBradleyTerryRatings(gameResultsFixture, optsFixture)
+
+	// Verify results
+	assert.Equal(t, 2, actual.Iterations)
+	assert.False(t, actual.Converged)
+}
+
+func TestBradleyTerryModel_Probabilities_GivenUnknownTeam_ShouldNotBeOk(t *testing.T) {
+	// Setup fixture
+	modelFixture := league.BradleyTerryModel{
+		Ratings: []league.BradleyTerryRating{{Rank: 1, Team: "Lions", Strength: 1}},
+	}
+
+	// Exercise SUT
+	_, _, _, ok := modelFixture.Probabilities("Lions", "Snakes")
+
+	// Verify results
+	assert.False(t, ok)
+}
+
+func bradleyTerryTeamsOf(ratings []league.BradleyTerryRating) []string {
+	teams := make([]string, len(ratings))
+	for i, rating := range ratings {
+		teams[i] = rating.Team
+	}
+	return teams
+}
@@ -0,0 +1,260 @@
+package league
+
+import (
+	"math"
+	"sort"
+)
+
+// --- CalculatePositionRanges related ---
+
+// PositionRange is the best and worst position a team can still finish in,
+// given the games remaining. Teams may finish level on points, so to be safe
+// whatever the tiebreakers, ties are assumed to go the team's way for its best
+// position, and against it for its worst.
+type PositionRange struct {
+	Best  uint
+	Worst uint
+	// Whether every remaining outcome was considered. Otherwise, the range is
+	// a safe bound: the team can do no better than Best, and no worse than
+	// Worst, but may not be able to reach either.
+	Exact bool
+}
+
+// Clinched is whether the team is sure to finish in the top positions.
+func (pr PositionRange) Clinched(positions uint) bool {
+	return pr.Worst <= positions
+}
+
+// Eliminated is whether the team can no longer finish in the top positions.
+func (pr PositionRange) Eliminated(positions uint) bool {
+	return pr.Best > positions
+}
+
+// Above this many combinations of outcomes for the remaining fixtures, ranges
+// are bounded rather than exact.
+const maxExactScenarios = 100000
+
+// Determine the range of positions each team can still finish in, given the
+// games played so far and the fixtures remaining, ranked as by
+// CalculateRankingsWithOptions. Ranges are exact when there are few enough
+// fixtures, and the points for each game depend only on who won. Otherwise,
+// they are bounded.
+func CalculatePositionRanges(played []GameResult, fixtures []Fixture, opts Options) map[string]PositionRange {
+	current := assignPointsForLeague(played, opts.Scoring)
+	applyAdjustments(current, opts.Adjustments)
+	for _, fixture := range fixtures {
+		lookupRanking(current, fixture.TeamA)
+		lookupRanking(current, fixture.TeamB)
+	}
+	teams := make([]string, 0, len(current))
+	for team := range current {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+
+	outcomes := remainingOutcomes(opts.Scoring)
+	if len(opts.Scoring.Bonuses) == 0 && scenarioCount(len(outcomes), len(fixtures)) <= maxExactScenarios {
+		return exactPositionRanges(teams, current, fixtures, outcomes, opts)
+	}
+	return boundedPositionRanges(teams, current, fixtures, opts)
+}
+
+// The distinct results a remaining game could have, from team A's side.
+func remainingOutcomes(scoring Scoring) []GameResult {
+	outcomes := []GameResult{
+		{ScoreA: 1, ScoreB: 0},
+		{ScoreA: 0, ScoreB: 0},
+		{ScoreA: 0, ScoreB: 1},
+	}
+	if scoring.Overtime != nil {
+		outcomes = append(outcomes,
+			GameResult{ScoreA: 1, ScoreB: 0, Decision: DecisionOvertime},
+			GameResult{ScoreA: 0, ScoreB: 1, Decision: DecisionOvertime})
+	}
+	if scoring.Shootout != nil {
+		outcomes = append(outcomes,
+			GameResult{ScoreA: 1, ScoreB: 0, Decision: DecisionShootout},
+			GameResult{ScoreA: 0, ScoreB: 1, Decision: DecisionShootout})
+	}
+	return outcomes
+}
+
+func scenarioCount(outcomes int, fixtures int) int {
+	count := 1
+	for i := 0; i < fixtures; i++ {
+		count *= outcomes
+		if count > maxExactScenarios {
+			return count
+		}
+	}
+	return count
+}
+
+func exactPositionRanges(
+	teams []string,
+	current map[string]*Ranking,
+	fixtures []Fixture,
+	outcomes []GameResult,
+	opts Options,
+) map[string]PositionRange {
+	teamsToIndices := make(map[string]int, len(teams))
+	base := make([]Ranking, len(teams))
+	for i, team := range teams {
+		teamsToIndices[team] = i
+		base[i] = *current[team]
+	}
+
+	// Work out the points and outcomes of each possible result up front.
+	type fixtureOutcome struct {
+		pointsA, pointsB   float64
+		outcomeA, outcomeB Outcome
+	}
+	fixtureOutcomes := make([]fixtureOutcome, len(outcomes))
+	for i, outcome := range outcomes {
+		pointsA, pointsB := opts.Scoring.AssignPoints(outcome)
+		outcomeA, outcomeB := outcome.Outcomes()
+		fixtureOutcomes[i] = fixtureOutcome{pointsA, pointsB, outcomeA, outcomeB}
+	}
+
+	ranges := make([]PositionRange, len(teams))
+	for i := range ranges {
+		ranges[i] = PositionRange{Best: math.MaxUint32, Exact: true}
+	}
+
+	value := metricValue(opts.Metric)
+	scenario := make([]Ranking, len(teams))
+	values := make([]float64, len(teams))
+	choices := make([]int, len(fixtures))
+	for {
+		copy(scenario, base)
+		for f, fixture := range fixtures {
+			a, b := teamsToIndices[fixture.TeamA], teamsToIndices[fixture.TeamB]
+			fo := fixtureOutcomes[choices[f]]
+			scenario[a].Points += fo.pointsA
+			scenario[b].Points += fo.pointsB
+			recordOutcome(&scenario[a], fo.outcomeA)
+			recordOutcome(&scenario[b], fo.outcomeB)
+		}
+
+		for i, ranking := range scenario {
+			values[i] = value(ranking)
+		}
+		for i := range scenario {
+			best, worst := uint(1), uint(1)
+			for j := range scenario {
+				if j == i {
+					continue
+				}
+				if values[j] > values[i] {
+					best++
+				}
+				if values[j] >= values[i] {
+					worst++
+				}
+			}
+			if best < ranges[i].Best {
+				ranges[i].Best = best
+			}
+			if worst > ranges[i].Worst {
+				ranges[i].Worst = worst
+			}
+		}
+
+		// Move on to the next combination of outcomes, like an odometer.
+		f := 0
+		for ; f < len(choices); f++ {
+			choices[f]++
+			if choices[f] < len(fixtureOutcomes) {
+				break
+			}
+			choices[f] = 0
+		}
+		if f == len(choices) {
+			break
+		}
+	}
+
+	teamsToRanges := make(map[string]PositionRange, len(teams))
+	for i, team := range teams {
+		teamsToRanges[team] = ranges[i]
+	}
+	return teamsToRanges
+}
+
+// A team can finish no better than behind those sure to finish above it, and
+// no worse than behind all those who could.
+func boundedPositionRanges(
+	teams []string,
+	current map[string]*Ranking,
+	fixtures []Fixture,
+	opts Options,
+) map[string]PositionRange {
+	remaining := make(map[string]uint, len(teams))
+	for _, fixture := range fixtures {
+		remaining[fixture.TeamA]++
+		remaining[fixture.TeamB]++
+	}
+
+	lows := make(map[string]float64, len(teams))
+	highs := make(map[string]float64, len(teams))
+	for _, team := range teams {
+		lows[team], highs[team] = valueBounds(*current[team], remaining[team], opts)
+	}
+
+	teamsToRanges := make(map[string]PositionRange, len(teams))
+	for _, team := range teams {
+		best, worst := uint(1), uint(1)
+		for _, other := range teams {
+			if other == team {
+				continue
+			}
+			if lows[other] > highs[team] {
+				best++
+			}
+			if highs[other] >= lows[team] {
+				worst++
+			}
+		}
+		teamsToRanges[team] = PositionRange{Best: best, Worst: worst}
+	}
+	return teamsToRanges
+}
+
+// The lowest and highest value of the ranking metric a team could finish
+// with, given its games remaining.
+func valueBounds(ranking Ranking, remaining uint, opts Options) (float64, float64) {
+	if opts.Metric == MetricWinPercentage {
+		played := ranking.Played + remaining
+		if played == 0 {
+			return 0, 0
+		}
+		wins := float64(ranking.Won) + float64(ranking.Drawn)/2
+		return wins / float64(played), (wins + float64(remaining)) / float64(played)
+	}
+
+	low, high := gamePointBounds(opts.Scoring)
+	return ranking.Points + low*float64(remaining), ranking.Points + high*float64(remaining)
+}
+
+// The fewest and most points a team could get from a single game.
+func gamePointBounds(scoring Scoring) (float64, float64) {
+	points := []float64{scoring.WinPoints, scoring.DrawPoints, scoring.LosePoints}
+	for _, decisionPoints := range []*DecisionPoints{scoring.Overtime, scoring.Shootout} {
+		if decisionPoints != nil {
+			points = append(points, decisionPoints.WinPoints, decisionPoints.LosePoints)
+		}
+	}
+	low, high := points[0], points[0]
+	for _, p := range points[1:] {
+		low, high = math.Min(low, p), math.Max(high, p)
+	}
+
+	for _, bonus := range scoring.Bonuses {
+		if bonus.Points < 0 {
+			low += bonus.Points
+		} else {
+			high += bonus.Points
+		}
+	}
+	return low, high
+}
@@ -0,0 +1,141 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculatePositionRanges_GivenNoFixtures_ShouldBeCurrentTable(t *testing.T) {
+	// Setup expectations
+	// -> FC Awesome and Snakes are level, so could finish 3rd or 4th.
+	expected := map[string]league.PositionRange{
+		"Tarantulas": {Best: 1, Worst: 1, Exact: true},
+		"Lions":      {Best: 2, Worst: 2, Exact: true},
+		"FC Awesome": {Best: 3, Worst: 4, Exact: true},
+		"Snakes":     {Best: 3, Worst: 4, Exact: true},
+		"Grouches":   {Best: 5, Worst: 5, Exact: true},
+	}
+
+	// Exercise SUT
+	actual := league.CalculatePositionRanges(simulationPlayedFixture(), nil, league.DefaultOptions())
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+}
+
+func TestCalculatePositionRanges_GivenFixtures_ShouldBeExact(t *testing.T) {
+	// Setup fixture
+	// -> Tarantulas 6, Lions 5, FC Awesome 1, Snakes 1, Grouches 0.
+	fixturesFixture := []league.Fixture{
+		{TeamA: "Lions", TeamB: "Snakes"},
+		{TeamA: "Grouches", TeamB: "FC Awesome"},
+	}
+
+	// Setup expectations
+	expected := map[string]league.PositionRange{
+		// -> Lions can overtake, or be level with, the Tarantulas.
+		"Tarantulas": {Best: 1, Worst: 2, Exact: true},
+		"Lions":      {Best: 1, Worst: 2, Exact: true},
+		// -> Snakes can reach 4 points, but not the Lions' 5.
+		"Snakes":     {Best: 3, Worst: 5, Exact: true},
+		"FC Awesome": {Best: 3, Worst: 5, Exact: true},
+		"Grouches":   {Best: 3, Worst: 5, Exact: true},
+	}
+
+	// Exercise SUT
+	actual := league.CalculatePositionRanges(simulationPlayedFixture(), fixturesFixture, league.DefaultOptions())
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+	assert.True(t, actual["Lions"].Clinched(2))
+	assert.False(t, actual["Lions"].Clinched(1))
+	assert.True(t, actual["Snakes"].Eliminated(2))
+	assert.False(t, actual["Snakes"].Eliminated(3))
+}
+
+func TestCalculatePositionRanges_GivenManyFixtures_ShouldBeBounded(t *testing.T) {
+	// Setup fixture
+	playedFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Grouches", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Tarantulas", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Grouches", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Tarantulas", ScoreB: 0},
+	}
+	// -> 3^12 combinations is too many to consider exactly.
+	fixturesFixture := make([]league.Fixture, 0, 12)
+	for i := 0; i < 4; i++ {
+		fixturesFixture = append(fixturesFixture,
+			league.Fixture{TeamA: "Snakes", TeamB: "Grouches"},
+			league.Fixture{TeamA: "Grouches", TeamB: "Tarantulas"},
+			league.Fixture{TeamA: "Tarantulas", TeamB: "Snakes"})
+	}
+
+	// Setup expectations
+	// -> The Lions have 18 points, and the others can reach at most 24.
+	expected := map[string]league.PositionRange{
+		"Lions":      {Best: 1, Worst: 4},
+		"Snakes":     {Best: 1, Worst: 4},
+		"Grouches":   {Best: 1, Worst: 4},
+		"Tarantulas": {Best: 1, Worst: 4},
+	}
+
+	// Exercise SUT
+	actual := league.CalculatePositionRanges(playedFixture, fixturesFixture, league.DefaultOptions())
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+}
+
+func TestCalculatePositionRanges_GivenBonuses_ShouldBeBounded(t *testing.T) {
+	// Setup fixture
+	playedFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 20, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 20, TeamB: "Grouches", ScoreB: 0},
+	}
+	fixturesFixture := []league.Fixture{{TeamA: "Snakes", TeamB: "Grouches"}}
+	optsFixture := league.Options{Scoring: rugbyScoring()}
+
+	// Setup expectations
+	// -> Lions have 8 points, and the others can reach at most 6 (4 + both bonuses).
+	expected := map[string]league.PositionRange{
+		"Lions":    {Best: 1, Worst: 1},
+		"Snakes":   {Best: 2, Worst: 3},
+		"Grouches": {Best: 2, Worst: 3},
+	}
+
+	// Exercise SUT
+	actual := league.CalculatePositionRanges(playedFixture, fixturesFixture, optsFixture)
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+}
+
+func TestCalculatePositionRanges_GivenWinPercentage_ShouldUseMetric(t *testing.T) {
+	// Setup fixture
+	playedFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 0},
+	}
+	fixturesFixture := []league.Fixture{
+		{TeamA: "Grouches", TeamB: "Snakes"},
+	}
+	preset, _ := league.LookupPreset("basketball")
+
+	// Setup expectations
+	// -> Grouches will be 1-0 or 0-1, so could top the Lions' 2-0 on percentage.
+	expected := map[string]league.PositionRange{
+		"Lions":    {Best: 1, Worst: 2, Exact: true},
+		"Grouches": {Best: 1, Worst: 3, Exact: true},
+		"Snakes":   {Best: 2, Worst: 3, Exact: true},
+	}
+
+	// Exercise SUT
+	actual := league.CalculatePositionRanges(playedFixture, fixturesFixture, preset.Options)
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+}
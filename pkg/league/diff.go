@@ -0,0 +1,63 @@
+package league
+
+// --- DiffRankings related ---
+
+// RankingChange is how a team's ranking changed between two tables.
+type RankingChange struct {
+	Team string
+	// Nil if the team is only in the after table.
+	Before *Ranking
+	// Nil if the team is only in the before table.
+	After *Ranking
+	// Positive if the team moved up the table. Zero for new or removed teams.
+	RankDelta int
+	// Zero for new or removed teams.
+	PointsDelta float64
+}
+
+// New is whether the team is only in the after table.
+func (rc RankingChange) New() bool {
+	return rc.Before == nil
+}
+
+// Removed is whether the team is only in the before table.
+func (rc RankingChange) Removed() bool {
+	return rc.After == nil
+}
+
+// Changed is whether the team's rank or points changed, or it is new or
+// removed.
+func (rc RankingChange) Changed() bool {
+	return rc.New() || rc.Removed() || rc.RankDelta != 0 || rc.PointsDelta != 0
+}
+
+// Determine how each team's ranking changed between two tables, e.g. before
+// and after a result was corrected. Changes are in the order of the after
+// table, followed by removed teams in the order of the before table.
+func DiffRankings(before []Ranking, after []Ranking) []RankingChange {
+	teamsToBefore := make(map[string]*Ranking, len(before))
+	for i := range before {
+		teamsToBefore[before[i].Team] = &before[i]
+	}
+
+	changes := make([]RankingChange, 0, len(after))
+	seen := make(map[string]bool, len(after))
+	for i := range after {
+		afterRanking := &after[i]
+		seen[afterRanking.Team] = true
+		change := RankingChange{Team: afterRanking.Team, After: afterRanking}
+		if beforeRanking, ok := teamsToBefore[afterRanking.Team]; ok {
+			change.Before = beforeRanking
+			change.RankDelta = int(beforeRanking.Rank) - int(afterRanking.Rank)
+			change.PointsDelta = afterRanking.Points - beforeRanking.Points
+		}
+		changes = append(changes, change)
+	}
+
+	for i := range before {
+		if !seen[before[i].Team] {
+			changes = append(changes, RankingChange{Team: before[i].Team, Before: &before[i]})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,54 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffRankings(t *testing.T) {
+	// Setup fixture
+	beforeFixture := []league.Ranking{
+		{Rank: 1, Team: "Tarantulas", Points: 6},
+		{Rank: 2, Team: "Lions", Points: 5},
+		{Rank: 3, Team: "Snakes", Points: 1},
+		{Rank: 4, Team: "Grouches", Points: 0},
+	}
+	afterFixture := []league.Ranking{
+		{Rank: 1, Team: "Lions", Points: 7},
+		{Rank: 2, Team: "Tarantulas", Points: 6},
+		{Rank: 3, Team: "Snakes", Points: 1},
+		{Rank: 4, Team: "Bears", Points: 0},
+	}
+
+	// Setup expectations
+	expected := []league.RankingChange{
+		{Team: "Lions", Before: &beforeFixture[1], After: &afterFixture[0], RankDelta: 1, PointsDelta: 2},
+		{Team: "Tarantulas", Before: &beforeFixture[0], After: &afterFixture[1], RankDelta: -1},
+		{Team: "Snakes", Before: &beforeFixture[2], After: &afterFixture[2]},
+		{Team: "Bears", After: &afterFixture[3]},
+		{Team: "Grouches", Before: &beforeFixture[3]},
+	}
+
+	// Exercise SUT
+	actual := league.DiffRankings(beforeFixture, afterFixture)
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+	assert.True(t, actual[0].Changed())
+	assert.True(t, actual[1].Changed())
+	assert.False(t, actual[2].Changed())
+	assert.True(t, actual[3].New())
+	assert.True(t, actual[3].Changed())
+	assert.True(t, actual[4].Removed())
+	assert.True(t, actual[4].Changed())
+}
+
+func TestDiffRankings_GivenNoRankings_ShouldReturnEmpty(t *testing.T) {
+	// Exercise SUT
+	actual := league.DiffRankings(nil, nil)
+
+	// Verify results
+	assert.Empty(t, actual)
+}
@@ -0,0 +1,277 @@
+package league
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// --- CalculateGlicko2Ratings related ---
+
+// See http://www.glicko.net/glicko/glicko2.pdf for the details of the system.
+const (
+	// Converts between the Glicko and Glicko-2 scales.
+	glicko2Scale = 173.7178
+	// Convergence tolerance for the volatility iteration.
+	glicko2Epsilon = 0.000001
+)
+
+// Glicko2Options customise how Glicko-2 ratings are calculated.
+type Glicko2Options struct {
+	// Every team starts with these.
+	InitialRating     float64
+	InitialDeviation  float64
+	InitialVolatility float64
+	// Constrains the change in volatility over time, typically 0.3 to 1.2.
+	Tau float64
+	// Zero groups games into rating periods by round. Otherwise, games are
+	// grouped by date into periods of this length, starting from the earliest
+	// date. Games without a date are treated as being on the earliest date.
+	PeriodLength time.Duration
+	// How teams level on rating are ranked.
+	RankingStyle RankingStyle
+}
+
+// DefaultGlicko2Options are the values suggested by the Glicko-2 paper.
+func DefaultGlicko2Options() Glicko2Options {
+	return Glicko2Options{
+		InitialRating:     1500,
+		InitialDeviation:  350,
+		InitialVolatility: 0.06,
+		Tau:               0.5,
+	}
+}
+
+// Glicko2Rating is a team's rating after all rating periods.
+type Glicko2Rating struct {
+	Rank uint
+	Team string
+	// The estimated strength of the team.
+	Rating float64
+	// The uncertainty in Rating. Roughly 95% of the time, the true strength
+	// is within two deviations of the rating.
+	Deviation float64
+	// The degree of expected fluctuation in the team's performance.
+	Volatility float64
+}
+
+// Glicko2Match is one game from the perspective of a team, within a rating
+// period.
+type Glicko2Match struct {
+	Opponent Glicko2Rating
+	// 1 for a win, 0.5 for a draw, 0 for a loss.
+	Score float64
+}
+
+// Determine the Glicko-2 ratings of all the teams in a league given game
+// results, ordered by rating. Abandoned games are ignored.
+func CalculateGlicko2Ratings(gameResults []GameResult, opts Glicko2Options) []Glicko2Rating {
+	teamsToRatings := make(map[string]Glicko2Rating)
+	for _, gameResult := range gameResults {
+		for _, team := range []string{gameResult.TeamA, gameResult.TeamB} {
+			teamsToRatings[team] = Glicko2Rating{
+				Team:       team,
+				Rating:     opts.InitialRating,
+				Deviation:  opts.InitialDeviation,
+				Volatility: opts.InitialVolatility,
+			}
+		}
+	}
+
+	for _, period := range groupRatingPeriods(gameResults, opts.PeriodLength) {
+		teamsToRatings = rateGlicko2Period(teamsToRatings, period, opts)
+	}
+
+	return rankGlicko2Ratings(teamsToRatings, opts.RankingStyle)
+}
+
+func groupRatingPeriods(gameResults []GameResult, periodLength time.Duration) [][]GameResult {
+	earliest := time.Time{}
+	for _, gameResult := range gameResults {
+		if !gameResult.Date.IsZero() && (earliest.IsZero() || gameResult.Date.Before(earliest)) {
+			earliest = gameResult.Date
+		}
+	}
+
+	periodsToGames := make(map[int64][]GameResult)
+	for _, gameResult := range gameResults {
+		if gameResult.Status == StatusAbandoned {
+			continue
+		}
+
+		period := int64(gameResult.Round)
+		if periodLength > 0 {
+			period = 0
+			if !gameResult.Date.IsZero() {
+				period = int64(gameResult.Date.Sub(earliest) / periodLength)
+			}
+		}
+		periodsToGames[period] = append(periodsToGames[period], gameResult)
+	}
+
+	if len(periodsToGames) == 0 {
+		return nil
+	}
+
+	// Periods without games still count, since deviations grow over time.
+	first, last := int64(math.MaxInt64), int64(math.MinInt64)
+	for period := range periodsToGames {
+		if period < first {
+			first = period
+		}
+		if period > last {
+			last = period
+		}
+	}
+
+	periods := make([][]GameResult, 0, last-first+1)
+	for period := first; period <= last; period++ {
+		periods = append(periods, periodsToGames[period])
+	}
+	return periods
+}
+
+func rateGlicko2Period(
+	teamsToRatings map[string]Glicko2Rating,
+	period []GameResult,
+	opts Glicko2Options,
+) map[string]Glicko2Rating {
+	// Ratings are updated simultaneously, using the ratings from before the period.
+	teamsToMatches := make(map[string][]Glicko2Match)
+	for _, gameResult := range period {
+		outcomeA, outcomeB := gameResult.Outcomes()
+		teamsToMatches[gameResult.TeamA] = append(teamsToMatches[gameResult.TeamA], Glicko2Match{
+			Opponent: teamsToRatings[gameResult.TeamB],
+			Score:    outcomeScore(outcomeA),
+		})
+		teamsToMatches[gameResult.TeamB] = append(teamsToMatches[gameResult.TeamB], Glicko2Match{
+			Opponent: teamsToRatings[gameResult.TeamA],
+			Score:    outcomeScore(outcomeB),
+		})
+	}
+
+	updated := make(map[string]Glicko2Rating, len(teamsToRatings))
+	for team, rating := range teamsToRatings {
+		rating = rating.Update(teamsToMatches[team], opts.Tau)
+		// Inactive teams should not become less certain than a new team.
+		rating.Deviation = math.Min(rating.Deviation, opts.InitialDeviation)
+		updated[team] = rating
+	}
+	return updated
+}
+
+func outcomeScore(outcome Outcome) float64 {
+	switch outcome {
+	case OutcomeWin:
+		return 1
+	case OutcomeDraw:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// Update the rating given the matches played in a rating period. If no
+// matches were played, only the deviation changes.
+func (r Glicko2Rating) Update(matches []Glicko2Match, tau float64) Glicko2Rating {
+	mu, phi := glicko2Mu(r.Rating), r.Deviation/glicko2Scale
+	if len(matches) == 0 {
+		r.Deviation = math.Sqrt(phi*phi+r.Volatility*r.Volatility) * glicko2Scale
+		return r
+	}
+
+	// Step 3 and 4: The estimated variance and improvement.
+	vInverse, deltaSum := 0.0, 0.0
+	for _, match := range matches {
+		muJ, phiJ := glicko2Mu(match.Opponent.Rating), match.Opponent.Deviation/glicko2Scale
+		g := glicko2G(phiJ)
+		e := glicko2E(mu, muJ, g)
+		vInverse += g * g * e * (1 - e)
+		deltaSum += g * (match.Score - e)
+	}
+	v := 1 / vInverse
+	delta := v * deltaSum
+
+	// Step 5: The new volatility.
+	sigma := glicko2Volatility(delta, phi, v, r.Volatility, tau)
+
+	// Step 6 and 7: The new deviation and rating.
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*deltaSum
+
+	r.Rating = newMu*glicko2Scale + 1500
+	r.Deviation = newPhi * glicko2Scale
+	r.Volatility = sigma
+	return r
+}
+
+func glicko2Mu(rating float64) float64 {
+	return (rating - 1500) / glicko2Scale
+}
+
+func glicko2G(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+func glicko2E(mu float64, muJ float64, g float64) float64 {
+	return 1 / (1 + math.Exp(-g*(mu-muJ)))
+}
+
+// Uses the Illinois algorithm, as described in step 5 of the paper.
+func glicko2Volatility(delta float64, phi float64, v float64, sigma float64, tau float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(tau*tau)
+	}
+
+	bigA := a
+	var bigB float64
+	if delta*delta > phi*phi+v {
+		bigB = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+		bigB = a - k*tau
+	}
+
+	fA, fB := f(bigA), f(bigB)
+	for math.Abs(bigB-bigA) > glicko2Epsilon {
+		bigC := bigA + (bigA-bigB)*fA/(fB-fA)
+		fC := f(bigC)
+		if fC*fB <= 0 {
+			bigA, fA = bigB, fB
+		} else {
+			fA /= 2
+		}
+		bigB, fB = bigC, fC
+	}
+	return math.Exp(bigA / 2)
+}
+
+func rankGlicko2Ratings(teamsToRatings map[string]Glicko2Rating, style RankingStyle) []Glicko2Rating {
+	ratings := make([]Glicko2Rating, 0, len(teamsToRatings))
+	for _, rating := range teamsToRatings {
+		ratings = append(ratings, rating)
+	}
+
+	// Sort by rating descending, then team name ascending
+	sort.Slice(ratings, func(i int, j int) bool {
+		a, b := ratings[i], ratings[j]
+		if a.Rating == b.Rating {
+			return a.Team < b.Team
+		}
+		return a.Rating > b.Rating
+	})
+
+	// Assign rank
+	ranks := assignRanks(len(ratings), func(i int) float64 { return ratings[i].Rating }, style)
+	for i := range ratings {
+		ratings[i].Rank = ranks[i]
+	}
+	return ratings
+}
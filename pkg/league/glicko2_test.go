@@ -0,0 +1,140 @@
+package league_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlicko2Rating_Update_GivenWorkedExample(t *testing.T) {
+	// Setup fixture
+	// -> This is the example from the Glicko-2 paper.
+	ratingFixture := league.Glicko2Rating{Rating: 1500, Deviation: 200, Volatility: 0.06}
+	matchesFixture := []league.Glicko2Match{
+		{Opponent: league.Glicko2Rating{Rating: 1400, Deviation: 30}, Score: 1},
+		{Opponent: league.Glicko2Rating{Rating: 1550, Deviation: 100}, Score: 0},
+		{Opponent: league.Glicko2Rating{Rating: 1700, Deviation: 300}, Score: 0},
+	}
+
+	// Exercise SUT
+	actual := ratingFixture.Update(matchesFixture, 0.5)
+
+	// Verify results
+	assert.InDelta(t, 1464.06, actual.Rating, 0.01)
+	assert.InDelta(t, 151.52, actual.Deviation, 0.01)
+	assert.InDelta(t, 0.05999, actual.Volatility, 0.00001)
+}
+
+func TestGlicko2Rating_Update_GivenNoMatches_ShouldOnlyIncreaseDeviation(t *testing.T) {
+	// Setup fixture
+	ratingFixture := league.Glicko2Rating{Rating: 1600, Deviation: 50, Volatility: 0.06}
+
+	// Exercise SUT
+	actual := ratingFixture.Update(nil, 0.5)
+
+	// Verify results
+	assert.Equal(t, 1600.0, actual.Rating)
+	assert.InDelta(t, 51.07, actual.Deviation, 0.01)
+	assert.Equal(t, 0.06, actual.Volatility)
+}
+
+func TestCalculateGlicko2Ratings_GivenNoGames(t *testing.T) {
+	// Exercise SUT
+	actual := league.CalculateGlicko2Ratings(nil, league.DefaultGlicko2Options())
+
+	// Verify results
+	assert.Empty(t, actual)
+}
+
+func TestCalculateGlicko2Ratings_GivenRankingStyle_ShouldRankLevelTeamsByIt(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 1},
+	}
+	optsFixture := league.DefaultGlicko2Options()
+	optsFixture.RankingStyle = league.RankingStyleOrdinal
+
+	// Exercise SUT
+	standard := league.CalculateGlicko2Ratings(gameResultsFixture, league.DefaultGlicko2Options())
+	ordinal := league.CalculateGlicko2Ratings(gameResultsFixture, optsFixture)
+
+	// Verify results
+	assert.Equal(t, []uint{1, 1}, []uint{standard[0].Rank, standard[1].Rank})
+	assert.Equal(t, []uint{1, 2}, []uint{ordinal[0].Rank, ordinal[1].Rank})
+}
+
+func TestCalculateGlicko2Ratings_GivenRounds(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 0, Round: 1},
+		{TeamA: "Tarantulas", ScoreA: 1, TeamB: "Grouches", ScoreB: 1, Round: 1},
+		{TeamA: "Lions", ScoreA: 2, TeamB: "Tarantulas", ScoreB: 0, Round: 2},
+		{TeamA: "Snakes", ScoreA: 0, TeamB: "Grouches", ScoreB: 0, Round: 2, Status: league.StatusAbandoned},
+	}
+
+	// Exercise SUT
+	actual := league.CalculateGlicko2Ratings(gameResultsFixture, league.DefaultGlicko2Options())
+
+	// Verify results
+	assert.Equal(t, []string{"Lions", "Grouches", "Tarantulas", "Snakes"}, glicko2TeamsOf(actual))
+	assert.Equal(t, uint(1), actual[0].Rank)
+	assert.Greater(t, actual[0].Rating, 1500.0)
+	assert.Less(t, actual[0].Deviation, 350.0)
+	// -> Grouches only played in round 1, so became less certain in round 2.
+	assert.Equal(t, 1500.0, actual[1].Rating)
+	assert.Less(t, actual[3].Rating, 1500.0)
+}
+
+func TestCalculateGlicko2Ratings_ShouldBeIndependentOfOrderWithinPeriod(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Snakes", ScoreA: 1, TeamB: "Grouches", ScoreB: 0},
+	}
+	reversedFixture := []league.GameResult{gameResultsFixture[1], gameResultsFixture[0]}
+
+	// Exercise SUT
+	actual := league.CalculateGlicko2Ratings(gameResultsFixture, league.DefaultGlicko2Options())
+	reversed := league.CalculateGlicko2Ratings(reversedFixture, league.DefaultGlicko2Options())
+
+	// Verify results
+	assert.Equal(t, actual, reversed)
+}
+
+func TestCalculateGlicko2Ratings_GivenPeriodLength_ShouldGroupByDate(t *testing.T) {
+	// Setup fixture
+	day := func(d int) time.Time {
+		return time.Date(2022, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	optsFixture := league.DefaultGlicko2Options()
+	optsFixture.PeriodLength = 7 * 24 * time.Hour
+	// -> The first two games are in the same week, so should be rated together
+	//    regardless of round.
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 0, Date: day(1), Round: 1},
+		{TeamA: "Snakes", ScoreA: 1, TeamB: "Grouches", ScoreB: 0, Date: day(3), Round: 2},
+		{TeamA: "Grouches", ScoreA: 1, TeamB: "Lions", ScoreB: 0, Date: day(9), Round: 3},
+	}
+	sameRoundFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 0, Round: 1},
+		{TeamA: "Snakes", ScoreA: 1, TeamB: "Grouches", ScoreB: 0, Round: 1},
+		{TeamA: "Grouches", ScoreA: 1, TeamB: "Lions", ScoreB: 0, Round: 2},
+	}
+
+	// Exercise SUT
+	actual := league.CalculateGlicko2Ratings(gameResultsFixture, optsFixture)
+	expected := league.CalculateGlicko2Ratings(sameRoundFixture, league.DefaultGlicko2Options())
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+}
+
+func glicko2TeamsOf(ratings []league.Glicko2Rating) []string {
+	teams := make([]string, len(ratings))
+	for i, rating := range ratings {
+		teams[i] = rating.Team
+	}
+	return teams
+}
@@ -1,8 +1,8 @@
 package league
 
 import (
-	"math"
 	"sort"
+	"time"
 )
 
 // --- CalculateRankings related ---
@@ -12,62 +12,238 @@ type GameResult struct {
 	ScoreA int
 	TeamB  string
 	ScoreB int
+	Status ResultStatus
+	// How a played game was decided.
+	Decision Decision
+	// When the game was played, if known. Zero values mean unknown.
+	Round uint
+	Date  time.Time
 }
 
+// ResultStatus indicates how a game result came about.
+type ResultStatus uint8
+
+const (
+	// The game was played out, and the scores stand.
+	StatusPlayed ResultStatus = iota
+	// Team A was awarded the game without a scoreline, e.g. by walkover.
+	StatusAwardedA
+	// Team B was awarded the game without a scoreline, e.g. by walkover.
+	StatusAwardedB
+	// The game was abandoned, and does not count towards the rankings.
+	StatusAbandoned
+)
+
+// Decision indicates at what stage a played game was decided.
+type Decision uint8
+
+const (
+	// Decided in normal time. This includes draws.
+	DecisionRegulation Decision = iota
+	// Decided in overtime, or extra time.
+	DecisionOvertime
+	// Decided by a shootout, e.g. penalties.
+	DecisionShootout
+)
+
 type Ranking struct {
 	Rank   uint
 	Team   string
-	Points int
+	Points float64
+	// The team's record, excluding abandoned games.
+	Played uint
+	Won    uint
+	Drawn  uint
+	Lost   uint
+	// Any adjustments which contributed to Points.
+	Adjustments []Adjustment
+}
+
+// The proportion of games won, counting draws as half a win. Zero if no games
+// have been played.
+func (r Ranking) WinPercentage() float64 {
+	if r.Played == 0 {
+		return 0
+	}
+	return (float64(r.Won) + float64(r.Drawn)/2) / float64(r.Played)
+}
+
+// Adjustment changes a team's points outside of any game, e.g. a deduction
+// for a rule breach.
+type Adjustment struct {
+	Team   string
+	Points float64
+	Reason string
+}
+
+// Metric is what teams are ranked by.
+type Metric uint8
+
+const (
+	MetricPoints Metric = iota
+	MetricWinPercentage
+)
+
+// RankingStyle is how teams which are level on the metric are ranked.
+type RankingStyle uint8
+
+const (
+	// Standard competition ranking, e.g. 1224: level teams share the highest
+	// rank, leaving a gap after them.
+	RankingStyleStandard RankingStyle = iota
+	// Dense ranking, e.g. 1223: level teams share a rank, without a gap.
+	RankingStyleDense
+	// Modified competition ranking, e.g. 1334: level teams share the lowest
+	// rank, leaving a gap before them.
+	RankingStyleModified
+	// Ordinal ranking, e.g. 1234: no ranks are shared, and level teams are
+	// ranked by name.
+	RankingStyleOrdinal
+)
+
+// Options customise how rankings are calculated.
+type Options struct {
+	Scoring Scoring
+	// Applied after points have been assigned for game results.
+	Adjustments  []Adjustment
+	Metric       Metric
+	RankingStyle RankingStyle
+}
+
+// DefaultOptions are the options used by CalculateRankings.
+func DefaultOptions() Options {
+	return Options{
+		Scoring: DefaultScoring(),
+	}
 }
 
 // Determine the ultimate ranking of all the teams in a league given game results.
 func CalculateRankings(gameResults []GameResult) []Ranking {
-	teamsToPoints := assignPointsForLeague(gameResults)
-	return rankTeamPoints(teamsToPoints)
+	return CalculateRankingsWithOptions(gameResults, DefaultOptions())
 }
 
-func assignPointsForLeague(gameResults []GameResult) map[string]int {
-	teamsToPoints := make(map[string]int)
+// Determine the ultimate ranking of all the teams in a league given game results,
+// customised by opts.
+func CalculateRankingsWithOptions(gameResults []GameResult, opts Options) []Ranking {
+	teamsToRankings := assignPointsForLeague(gameResults, opts.Scoring)
+	applyAdjustments(teamsToRankings, opts.Adjustments)
+	return rankTeams(teamsToRankings, opts.Metric, opts.RankingStyle)
+}
+
+func assignPointsForLeague(gameResults []GameResult, scoring Scoring) map[string]*Ranking {
+	teamsToRankings := make(map[string]*Ranking)
 	for _, gameResult := range gameResults {
-		pointsA, pointsB := AssignPoints(gameResult.ScoreA, gameResult.ScoreB)
-		teamsToPoints[gameResult.TeamA] += pointsA
-		teamsToPoints[gameResult.TeamB] += pointsB
+		rankingA := lookupRanking(teamsToRankings, gameResult.TeamA)
+		rankingB := lookupRanking(teamsToRankings, gameResult.TeamB)
+		if gameResult.Status == StatusAbandoned {
+			continue
+		}
+
+		pointsA, pointsB := scoring.AssignPoints(gameResult)
+		rankingA.Points += pointsA
+		rankingB.Points += pointsB
+
+		outcomeA, outcomeB := gameResult.Outcomes()
+		recordOutcome(rankingA, outcomeA)
+		recordOutcome(rankingB, outcomeB)
+	}
+	return teamsToRankings
+}
+
+func lookupRanking(teamsToRankings map[string]*Ranking, team string) *Ranking {
+	ranking, ok := teamsToRankings[team]
+	if !ok {
+		ranking = &Ranking{Team: team}
+		teamsToRankings[team] = ranking
 	}
-	return teamsToPoints
+	return ranking
 }
 
-func rankTeamPoints(teamsToPoints map[string]int) []Ranking {
+func recordOutcome(ranking *Ranking, outcome Outcome) {
+	ranking.Played++
+	switch outcome {
+	case OutcomeWin:
+		ranking.Won++
+	case OutcomeDraw:
+		ranking.Drawn++
+	default:
+		ranking.Lost++
+	}
+}
+
+func applyAdjustments(teamsToRankings map[string]*Ranking, adjustments []Adjustment) {
+	// A team may be adjusted without having played, so it should still be ranked.
+	for _, adjustment := range adjustments {
+		ranking := lookupRanking(teamsToRankings, adjustment.Team)
+		ranking.Points += adjustment.Points
+		ranking.Adjustments = append(ranking.Adjustments, adjustment)
+	}
+}
+
+func rankTeams(teamsToRankings map[string]*Ranking, metric Metric, style RankingStyle) []Ranking {
 	// Just convert to a list
-	rankings := make([]Ranking, len(teamsToPoints))
-	count := -1
-	for team, points := range teamsToPoints {
-		count++
-		rankings[count] = Ranking{Team: team, Points: points}
+	rankings := make([]Ranking, 0, len(teamsToRankings))
+	for _, ranking := range teamsToRankings {
+		rankings = append(rankings, *ranking)
 	}
 
-	// Sort by points descending, then team name ascending
+	// Sort by metric descending, then team name ascending
+	value := metricValue(metric)
 	sort.Slice(rankings, func(i int, j int) bool {
-		a, b := rankings[i], rankings[j]
-		if a.Points == b.Points {
-			return a.Team < b.Team
+		a, b := value(rankings[i]), value(rankings[j])
+		if a == b {
+			return rankings[i].Team < rankings[j].Team
 		}
-		return a.Points > b.Points
+		return a > b
 	})
 
 	// Assign rank
-	currRank := uint(0)
-	currPoints := math.MaxInt
-	for i, ranking := range rankings {
-		if ranking.Points < currPoints {
-			currRank = uint(i + 1)
-			currPoints = ranking.Points
-		}
-		rankings[i].Rank = currRank
+	ranks := assignRanks(len(rankings), func(i int) float64 { return value(rankings[i]) }, style)
+	for i := range rankings {
+		rankings[i].Rank = ranks[i]
 	}
 
 	return rankings
 }
 
+// Ranks for count values, sorted in descending order, where level values are
+// ranked according to style.
+func assignRanks(count int, value func(i int) float64, style RankingStyle) []uint {
+	ranks := make([]uint, count)
+	denseRank := uint(0)
+	for start := 0; start < count; {
+		end := start + 1
+		for end < count && value(end) == value(start) {
+			end++
+		}
+		denseRank++
+
+		for i := start; i < end; i++ {
+			switch style {
+			case RankingStyleDense:
+				ranks[i] = denseRank
+			case RankingStyleModified:
+				ranks[i] = uint(end)
+			case RankingStyleOrdinal:
+				ranks[i] = uint(i + 1)
+			default:
+				ranks[i] = uint(start + 1)
+			}
+		}
+		start = end
+	}
+	return ranks
+}
+
+func metricValue(metric Metric) func(Ranking) float64 {
+	if metric == MetricWinPercentage {
+		return Ranking.WinPercentage
+	}
+	return func(ranking Ranking) float64 {
+		return ranking.Points
+	}
+}
+
 // --- AssignPoints related ---
 
 const (
@@ -76,6 +252,105 @@ const (
 	LosePoints = 0
 )
 
+// Scoring configures how points are assigned for game results.
+type Scoring struct {
+	WinPoints  float64
+	DrawPoints float64
+	LosePoints float64
+	// Points for the team awarded a game without a scoreline, e.g. by walkover.
+	ForfeitWinPoints float64
+	// Points for the team which forfeited a game.
+	ForfeitLosePoints float64
+	// Points for games decided in overtime or by a shootout. Nil means the
+	// same as a game decided in regulation.
+	Overtime *DecisionPoints
+	Shootout *DecisionPoints
+	// Extra points awarded on top of the above for played games.
+	Bonuses []BonusRule
+}
+
+// DecisionPoints are the points for winning or losing a game decided after
+// regulation.
+type DecisionPoints struct {
+	WinPoints  float64
+	LosePoints float64
+}
+
+// DefaultScoring awards points as AssignPoints does, and treats a forfeit the
+// same as any other win or loss.
+func DefaultScoring() Scoring {
+	return Scoring{
+		WinPoints:         WinPoints,
+		DrawPoints:        DrawPoints,
+		LosePoints:        LosePoints,
+		ForfeitWinPoints:  WinPoints,
+		ForfeitLosePoints: LosePoints,
+	}
+}
+
+// Assign points to A and B given a game result. Awarded games score the
+// configured forfeit points, and abandoned games score nothing.
+func (s Scoring) AssignPoints(gameResult GameResult) (pointsA float64, pointsB float64) {
+	switch gameResult.Status {
+	case StatusAwardedA:
+		return s.ForfeitWinPoints, s.ForfeitLosePoints
+	case StatusAwardedB:
+		return s.ForfeitLosePoints, s.ForfeitWinPoints
+	case StatusAbandoned:
+		return 0, 0
+	default:
+		return s.assignPlayedPoints(gameResult.ScoreA, gameResult.ScoreB, gameResult.Decision)
+	}
+}
+
+func (s Scoring) assignPlayedPoints(
+	scoreA int,
+	scoreB int,
+	decision Decision,
+) (pointsA float64, pointsB float64) {
+	pointsA = s.outcomePoints(DetermineOutcome(scoreA, scoreB), decision)
+	pointsB = s.outcomePoints(DetermineOutcome(scoreB, scoreA), decision)
+
+	for _, bonus := range s.Bonuses {
+		if bonus.Applies(scoreA, scoreB) {
+			pointsA += bonus.Points
+		}
+		if bonus.Applies(scoreB, scoreA) {
+			pointsB += bonus.Points
+		}
+	}
+	return pointsA, pointsB
+}
+
+func (s Scoring) outcomePoints(outcome Outcome, decision Decision) float64 {
+	if decisionPoints := s.decisionPoints(decision); decisionPoints != nil && outcome != OutcomeDraw {
+		if outcome == OutcomeWin {
+			return decisionPoints.WinPoints
+		}
+		return decisionPoints.LosePoints
+	}
+
+	switch outcome {
+	case OutcomeWin:
+		return s.WinPoints
+	case OutcomeDraw:
+		return s.DrawPoints
+	default:
+		return s.LosePoints
+	}
+}
+
+func (s Scoring) decisionPoints(decision Decision) *DecisionPoints {
+	switch decision {
+	case DecisionOvertime:
+		return s.Overtime
+	case DecisionShootout:
+		return s.Shootout
+	default:
+		return nil
+	}
+}
+
 // Assign points to A and B given their relative scores.
 func AssignPoints(scoreA int, scoreB int) (pointsA int, pointsB int) {
 	if scoreA == scoreB {
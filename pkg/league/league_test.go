@@ -22,42 +22,55 @@ func TestCalculateRankings(t *testing.T) {
 
 		// One game cases
 		{
-			[]league.GameResult{{"Albatros", 5, "Baboon", 2}},
+			[]league.GameResult{{TeamA: "Albatros", ScoreA: 5, TeamB: "Baboon", ScoreB: 2}},
 			[]league.Ranking{
-				{1, "Albatros", 3},
-				{2, "Baboon", 0},
+				{Rank: 1, Team: "Albatros", Points: 3, Played: 1, Won: 1},
+				{Rank: 2, Team: "Baboon", Points: 0, Played: 1, Lost: 1},
 			},
 		},
 		{
-			[]league.GameResult{{"Alphonse", 4, "Barry", 4}},
+			[]league.GameResult{{TeamA: "Alphonse", ScoreA: 4, TeamB: "Barry", ScoreB: 4}},
 			[]league.Ranking{
-				{1, "Alphonse", 1},
-				{1, "Barry", 1},
+				{Rank: 1, Team: "Alphonse", Points: 1, Played: 1, Drawn: 1},
+				{Rank: 1, Team: "Barry", Points: 1, Played: 1, Drawn: 1},
 			},
 		},
 		{
-			[]league.GameResult{{"Barry", 4, "Alphonse", 4}},
+			[]league.GameResult{{TeamA: "Barry", ScoreA: 4, TeamB: "Alphonse", ScoreB: 4}},
 			[]league.Ranking{
-				{1, "Alphonse", 1},
-				{1, "Barry", 1},
+				{Rank: 1, Team: "Alphonse", Points: 1, Played: 1, Drawn: 1},
+				{Rank: 1, Team: "Barry", Points: 1, Played: 1, Drawn: 1},
+			},
+		},
+
+		// Walkover and abandoned cases
+		{
+			[]league.GameResult{
+				{TeamA: "Lions", TeamB: "Snakes", Status: league.StatusAwardedA},
+				{TeamA: "Lions", ScoreA: 0, TeamB: "Grouches", ScoreB: 4, Status: league.StatusAbandoned},
+			},
+			[]league.Ranking{
+				{Rank: 1, Team: "Lions", Points: 3, Played: 1, Won: 1},
+				{Rank: 2, Team: "Grouches", Points: 0},
+				{Rank: 2, Team: "Snakes", Points: 0, Played: 1, Lost: 1},
 			},
 		},
 
 		// Mixed case
 		{
 			[]league.GameResult{
-				{"Lions", 3, "Snakes", 3},
-				{"Tarantulas", 1, "FC Awesome", 0},
-				{"Lions", 1, "FC Awesome", 1},
-				{"Tarantulas", 3, "Snakes", 1},
-				{"Lions", 4, "Grouches", 0},
+				{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 3},
+				{TeamA: "Tarantulas", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 0},
+				{TeamA: "Lions", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 1},
+				{TeamA: "Tarantulas", ScoreA: 3, TeamB: "Snakes", ScoreB: 1},
+				{TeamA: "Lions", ScoreA: 4, TeamB: "Grouches", ScoreB: 0},
 			},
 			[]league.Ranking{
-				{1, "Tarantulas", 6},
-				{2, "Lions", 5},
-				{3, "FC Awesome", 1},
-				{3, "Snakes", 1},
-				{5, "Grouches", 0},
+				{Rank: 1, Team: "Tarantulas", Points: 6, Played: 2, Won: 2},
+				{Rank: 2, Team: "Lions", Points: 5, Played: 3, Won: 1, Drawn: 2},
+				{Rank: 3, Team: "FC Awesome", Points: 1, Played: 2, Drawn: 1, Lost: 1},
+				{Rank: 3, Team: "Snakes", Points: 1, Played: 2, Drawn: 1, Lost: 1},
+				{Rank: 5, Team: "Grouches", Points: 0, Played: 1, Lost: 1},
 			},
 		},
 	}
@@ -73,6 +86,77 @@ func TestCalculateRankings(t *testing.T) {
 	}
 }
 
+func TestCalculateRankingsWithOptions_GivenAdjustments(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Grouches", ScoreB: 0},
+	}
+	optsFixture := league.DefaultOptions()
+	optsFixture.Adjustments = []league.Adjustment{
+		{Team: "Lions", Points: -4, Reason: "financial breach"},
+		{Team: "Lions", Points: -1, Reason: "fielded an ineligible player"},
+		{Team: "Tarantulas", Points: 1},
+	}
+
+	// Setup expectations
+	rankingsExpected := []league.Ranking{
+		{Rank: 1, Team: "Lions", Points: 1, Played: 2, Won: 2, Adjustments: optsFixture.Adjustments[:2]},
+		{Rank: 1, Team: "Tarantulas", Points: 1, Adjustments: optsFixture.Adjustments[2:]},
+		{Rank: 3, Team: "Grouches", Points: 0, Played: 1, Lost: 1},
+		{Rank: 3, Team: "Snakes", Points: 0, Played: 1, Lost: 1},
+	}
+
+	// Exercise SUT
+	rankingsActual := league.CalculateRankingsWithOptions(gameResultsFixture, optsFixture)
+
+	// Verify results
+	assert.Equal(t, rankingsExpected, rankingsActual)
+}
+
+func TestCalculateRankingsWithOptions_GivenRankingStyle(t *testing.T) {
+	// Setup fixture
+	// -> Tarantulas 6, Lions 5, FC Awesome 1, Snakes 1, Grouches 0.
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 3},
+		{TeamA: "Tarantulas", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 1},
+		{TeamA: "Tarantulas", ScoreA: 3, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Lions", ScoreA: 4, TeamB: "Grouches", ScoreB: 0},
+	}
+
+	// Setup expectations
+	cases := []struct {
+		styleFixture  league.RankingStyle
+		ranksExpected []uint
+	}{
+		{league.RankingStyleStandard, []uint{1, 2, 3, 3, 5}},
+		{league.RankingStyleDense, []uint{1, 2, 3, 3, 4}},
+		{league.RankingStyleModified, []uint{1, 2, 4, 4, 5}},
+		{league.RankingStyleOrdinal, []uint{1, 2, 3, 4, 5}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %d", i), func(t *testing.T) {
+			optsFixture := league.DefaultOptions()
+			optsFixture.RankingStyle = c.styleFixture
+
+			// Exercise SUT
+			rankingsActual := league.CalculateRankingsWithOptions(gameResultsFixture, optsFixture)
+
+			// Verify results
+			ranksActual := make([]uint, len(rankingsActual))
+			teamsActual := make([]string, len(rankingsActual))
+			for j, ranking := range rankingsActual {
+				ranksActual[j] = ranking.Rank
+				teamsActual[j] = ranking.Team
+			}
+			assert.Equal(t, c.ranksExpected, ranksActual)
+			assert.Equal(t, []string{"Tarantulas", "Lions", "FC Awesome", "Snakes", "Grouches"}, teamsActual)
+		})
+	}
+}
+
 func TestAssignPoints(t *testing.T) {
 	// Setup fixture and expectations
 	cases := []struct {
@@ -113,3 +197,46 @@ func TestAssignPoints(t *testing.T) {
 		})
 	}
 }
+
+func TestScoring_AssignPoints(t *testing.T) {
+	// Setup fixture and expectations
+	scoringFixture := league.DefaultScoring()
+	scoringFixture.ForfeitWinPoints = 2
+	scoringFixture.ForfeitLosePoints = -1
+	cases := []struct {
+		gameResultFixture league.GameResult
+		pointsAExpected   float64
+		pointsBExpected   float64
+	}{
+		// Played games use the scores
+		{
+			league.GameResult{TeamA: "A", ScoreA: 2, TeamB: "B", ScoreB: 1},
+			3, 0,
+		},
+		// Awarded games use the forfeit points
+		{
+			league.GameResult{TeamA: "A", TeamB: "B", Status: league.StatusAwardedA},
+			2, -1,
+		},
+		{
+			league.GameResult{TeamA: "A", TeamB: "B", Status: league.StatusAwardedB},
+			-1, 2,
+		},
+		// Abandoned games score nothing, regardless of scores
+		{
+			league.GameResult{TeamA: "A", ScoreA: 5, TeamB: "B", ScoreB: 0, Status: league.StatusAbandoned},
+			0, 0,
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %d", i), func(t *testing.T) {
+			// Exercise SUT
+			pointsAActual, pointsBActual := scoringFixture.AssignPoints(c.gameResultFixture)
+
+			// Verify results
+			assert.Equal(t, c.pointsAExpected, pointsAActual)
+			assert.Equal(t, c.pointsBExpected, pointsBActual)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package league
+
+import (
+	"math"
+	"sort"
+)
+
+// --- CalculateColleyRatings and CalculateMasseyRatings related ---
+
+// MatrixOptions customise how matrix ratings are ranked.
+type MatrixOptions struct {
+	// How teams level on rating are ranked.
+	RankingStyle RankingStyle
+}
+
+// DefaultMatrixOptions rank teams level on rating as most leagues do.
+func DefaultMatrixOptions() MatrixOptions {
+	return MatrixOptions{RankingStyle: RankingStyleStandard}
+}
+
+// MatrixRating is a team's rating from a method which solves a linear system
+// over the whole league, such as Colley or Massey.
+type MatrixRating struct {
+	Rank   uint
+	Team   string
+	Rating float64
+}
+
+// Determine the Colley ratings of all the teams in a league given game
+// results, ordered by rating. Ratings only depend on wins and losses, are
+// centred on 0.5, and account for the strength of each team's opponents.
+// Draws count as half a win and half a loss, awarded games count as a win and
+// a loss, and abandoned games are ignored.
+//
+// See Colley (2002), "Colley's Bias Free College Football Ranking Method".
+func CalculateColleyRatings(gameResults []GameResult, opts MatrixOptions) []MatrixRating {
+	ml := newMatrixLeague(gameResults, func(gameResult GameResult) bool {
+		return gameResult.Status != StatusAbandoned
+	})
+
+	// C r = b, where C = 2I + diag(games played) - (games between teams), and
+	// b = 1 + (wins - losses) / 2.
+	n := len(ml.teams)
+	c := ml.gamesMatrix()
+	b := make([]float64, n)
+	for i := range ml.teams {
+		c[i][i] += 2
+		b[i] = 1
+	}
+	for _, gameResult := range ml.gameResults {
+		a, bIdx := ml.indices[gameResult.TeamA], ml.indices[gameResult.TeamB]
+		outcomeA, _ := gameResult.Outcomes()
+		switch outcomeA {
+		case OutcomeWin:
+			b[a] += 0.5
+			b[bIdx] -= 0.5
+		case OutcomeLoss:
+			b[a] -= 0.5
+			b[bIdx] += 0.5
+		}
+	}
+
+	return ml.rank(solveLinearSystem(c, b), opts.RankingStyle)
+}
+
+// Determine the Massey ratings of all the teams in a league given game
+// results, ordered by rating. The difference between two teams' ratings
+// estimates the margin between them, by least squares over the score margins
+// of all games. Ratings sum to zero within each group of teams which are
+// connected by games. Awarded and abandoned games are ignored, as they have
+// no meaningful scoreline.
+//
+// See Massey (1997), "Statistical Models Applied to the Rating of Sports
+// Teams".
+func CalculateMasseyRatings(gameResults []GameResult, opts MatrixOptions) []MatrixRating {
+	ml := newMatrixLeague(gameResults, func(gameResult GameResult) bool {
+		return gameResult.Status == StatusPlayed
+	})
+
+	// M r = p, where M = diag(games played) - (games between teams), and p is
+	// the total score margin of each team.
+	n := len(ml.teams)
+	m := ml.gamesMatrix()
+	p := make([]float64, n)
+	for _, gameResult := range ml.gameResults {
+		a, b := ml.indices[gameResult.TeamA], ml.indices[gameResult.TeamB]
+		margin := float64(gameResult.ScoreA - gameResult.ScoreB)
+		p[a] += margin
+		p[b] -= margin
+	}
+
+	// M is singular, since ratings could be shifted by any constant. So, for
+	// each connected group of teams, one equation is replaced with the
+	// constraint that their ratings sum to zero.
+	for _, component := range ml.components() {
+		last := component[len(component)-1]
+		for j := range m[last] {
+			m[last][j] = 0
+		}
+		for _, i := range component {
+			m[last][i] = 1
+		}
+		p[last] = 0
+	}
+
+	return ml.rank(solveLinearSystem(m, p), opts.RankingStyle)
+}
+
+type matrixLeague struct {
+	// Sorted, so that results are deterministic.
+	teams   []string
+	indices map[string]int
+	// Only those which count towards the ratings.
+	gameResults []GameResult
+}
+
+func newMatrixLeague(gameResults []GameResult, counts func(GameResult) bool) *matrixLeague {
+	indices := make(map[string]int)
+	for _, gameResult := range gameResults {
+		indices[gameResult.TeamA] = 0
+		indices[gameResult.TeamB] = 0
+	}
+	teams := make([]string, 0, len(indices))
+	for team := range indices {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+	for i, team := range teams {
+		indices[team] = i
+	}
+
+	counted := make([]GameResult, 0, len(gameResults))
+	for _, gameResult := range gameResults {
+		if counts(gameResult) {
+			counted = append(counted, gameResult)
+		}
+	}
+
+	return &matrixLeague{
+		teams:       teams,
+		indices:     indices,
+		gameResults: counted,
+	}
+}
+
+// The games played by each team on the diagonal, and the negated number of
+// games between each pair of teams elsewhere.
+func (ml *matrixLeague) gamesMatrix() [][]float64 {
+	matrix := make([][]float64, len(ml.teams))
+	for i := range matrix {
+		matrix[i] = make([]float64, len(ml.teams))
+	}
+	for _, gameResult := range ml.gameResults {
+		a, b := ml.indices[gameResult.TeamA], ml.indices[gameResult.TeamB]
+		if a == b {
+			continue
+		}
+		matrix[a][a]++
+		matrix[b][b]++
+		matrix[a][b]--
+		matrix[b][a]--
+	}
+	return matrix
+}
+
+// Groups of teams connected by games, each in ascending index order.
+func (ml *matrixLeague) components() [][]int {
+	parents := make([]int, len(ml.teams))
+	for i := range parents {
+		parents[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+	for _, gameResult := range ml.gameResults {
+		a, b := find(ml.indices[gameResult.TeamA]), find(ml.indices[gameResult.TeamB])
+		if a < b {
+			parents[b] = a
+		} else {
+			parents[a] = b
+		}
+	}
+
+	rootsToComponents := make(map[int][]int)
+	roots := make([]int, 0)
+	for i := range ml.teams {
+		root := find(i)
+		if _, ok := rootsToComponents[root]; !ok {
+			roots = append(roots, root)
+		}
+		rootsToComponents[root] = append(rootsToComponents[root], i)
+	}
+
+	components := make([][]int, len(roots))
+	for i, root := range roots {
+		components[i] = rootsToComponents[root]
+	}
+	return components
+}
+
+func (ml *matrixLeague) rank(ratingValues []float64, style RankingStyle) []MatrixRating {
+	ratings := make([]MatrixRating, len(ml.teams))
+	for i, team := range ml.teams {
+		ratings[i] = MatrixRating{Team: team, Rating: ratingValues[i]}
+	}
+
+	// Sort by rating descending, then team name ascending
+	sort.Slice(ratings, func(i int, j int) bool {
+		a, b := ratings[i], ratings[j]
+		if a.Rating == b.Rating {
+			return a.Team < b.Team
+		}
+		return a.Rating > b.Rating
+	})
+
+	// Assign rank
+	ranks := assignRanks(len(ratings), func(i int) float64 { return ratings[i].Rating }, style)
+	for i := range ratings {
+		ratings[i].Rank = ranks[i]
+	}
+	return ratings
+}
+
+// Solve a x = b by Gaussian elimination with partial pivoting. a must be
+// square and non-singular. a and b are modified.
+func solveLinearSystem(a [][]float64, b []float64) []float64 {
+	n := len(b)
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+
+		for row := col + 1; row < n; row++ {
+			factor := a[row][col] / a[col][col]
+			if factor == 0 {
+				continue
+			}
+			for k := col; k < n; k++ {
+				a[row][k] -= factor * a[col][k]
+			}
+			b[row] -= factor * b[col]
+		}
+	}
+
+	x := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := b[row]
+		for k := row + 1; k < n; k++ {
+			sum -= a[row][k] * x[k]
+		}
+		x[row] = sum / a[row][row]
+	}
+	return x
+}
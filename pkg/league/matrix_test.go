@@ -0,0 +1,150 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+// The 2005 ACC football games used as the running example in Langville and
+// Meyer (2012), "Who's #1? The Science of Rating and Ranking".
+func accFootballGameResults() []league.GameResult {
+	return []league.GameResult{
+		{TeamA: "Duke", ScoreA: 7, TeamB: "Miami", ScoreB: 52},
+		{TeamA: "Duke", ScoreA: 21, TeamB: "UNC", ScoreB: 24},
+		{TeamA: "Duke", ScoreA: 7, TeamB: "UVA", ScoreB: 38},
+		{TeamA: "Duke", ScoreA: 0, TeamB: "VT", ScoreB: 45},
+		{TeamA: "Miami", ScoreA: 34, TeamB: "UNC", ScoreB: 16},
+		{TeamA: "Miami", ScoreA: 25, TeamB: "UVA", ScoreB: 17},
+		{TeamA: "Miami", ScoreA: 27, TeamB: "VT", ScoreB: 7},
+		{TeamA: "UNC", ScoreA: 7, TeamB: "UVA", ScoreB: 5},
+		{TeamA: "UNC", ScoreA: 3, TeamB: "VT", ScoreB: 30},
+		{TeamA: "UVA", ScoreA: 14, TeamB: "VT", ScoreB: 52},
+	}
+}
+
+func TestCalculateColleyRatings_GivenWorkedExample(t *testing.T) {
+	// Exercise SUT
+	actual := league.CalculateColleyRatings(accFootballGameResults(), league.DefaultMatrixOptions())
+
+	// Verify results
+	assert.Equal(t, []string{"Miami", "VT", "UNC", "UVA", "Duke"}, matrixTeamsOf(actual))
+	assertMatrixRatings(t, map[string]float64{
+		"Miami": 0.7857,
+		"VT":    0.6429,
+		"UNC":   0.5,
+		"UVA":   0.3571,
+		"Duke":  0.2143,
+	}, actual)
+}
+
+func TestCalculateColleyRatings_GivenDrawsAndAbandonedGames(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Lions", ScoreA: 5, TeamB: "Snakes", ScoreB: 0, Status: league.StatusAbandoned},
+		{TeamA: "Grouches", TeamB: "Lions", Status: league.StatusAwardedA},
+	}
+
+	// Exercise SUT
+	actual := league.CalculateColleyRatings(gameResultsFixture, league.DefaultMatrixOptions())
+
+	// Verify results
+	// -> Draws leave ratings at 0.5, while the walkover counts as a win.
+	assert.Equal(t, []string{"Grouches", "Snakes", "Lions"}, matrixTeamsOf(actual))
+	assert.Greater(t, actual[0].Rating, 0.5)
+	assert.Less(t, actual[2].Rating, 0.5)
+	// -> The ratings are centred on 0.5.
+	assert.InDelta(t, 1.5, actual[0].Rating+actual[1].Rating+actual[2].Rating, 1e-9)
+}
+
+func TestCalculateMasseyRatings_GivenWorkedExample(t *testing.T) {
+	// Exercise SUT
+	actual := league.CalculateMasseyRatings(accFootballGameResults(), league.DefaultMatrixOptions())
+
+	// Verify results
+	assert.Equal(t, []string{"Miami", "VT", "UVA", "UNC", "Duke"}, matrixTeamsOf(actual))
+	assertMatrixRatings(t, map[string]float64{
+		"Miami": 18.2,
+		"VT":    18.0,
+		"UVA":   -3.4,
+		"UNC":   -8.0,
+		"Duke":  -24.8,
+	}, actual)
+}
+
+func TestCalculateMasseyRatings_GivenSeparateGroups_ShouldCentreEachOnZero(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Tarantulas", ScoreA: 0, TeamB: "Grouches", ScoreB: 4},
+		{TeamA: "Tarantulas", TeamB: "Lions", Status: league.StatusAwardedA},
+	}
+
+	// Exercise SUT
+	actual := league.CalculateMasseyRatings(gameResultsFixture, league.DefaultMatrixOptions())
+
+	// Verify results
+	assert.Equal(t, []string{"Grouches", "Lions", "Snakes", "Tarantulas"}, matrixTeamsOf(actual))
+	assertMatrixRatings(t, map[string]float64{
+		"Grouches":   2,
+		"Lions":      1,
+		"Snakes":     -1,
+		"Tarantulas": -2,
+	}, actual)
+}
+
+func TestCalculateMatrixRatings_GivenNoGames_ShouldReturnEmpty(t *testing.T) {
+	// Exercise SUT
+	colley := league.CalculateColleyRatings(nil, league.DefaultMatrixOptions())
+	massey := league.CalculateMasseyRatings(nil, league.DefaultMatrixOptions())
+
+	// Verify results
+	assert.Empty(t, colley)
+	assert.Empty(t, massey)
+}
+
+func TestCalculateMatrixRatings_GivenRankingStyle_ShouldRankLevelTeamsByIt(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Snakes", ScoreB: 1},
+	}
+	optsFixture := league.MatrixOptions{RankingStyle: league.RankingStyleOrdinal}
+
+	// Exercise SUT
+	colleyStandard := league.CalculateColleyRatings(gameResultsFixture, league.DefaultMatrixOptions())
+	colleyOrdinal := league.CalculateColleyRatings(gameResultsFixture, optsFixture)
+	masseyStandard := league.CalculateMasseyRatings(gameResultsFixture, league.DefaultMatrixOptions())
+	masseyOrdinal := league.CalculateMasseyRatings(gameResultsFixture, optsFixture)
+
+	// Verify results
+	assert.Equal(t, []uint{1, 1}, matrixRanksOf(colleyStandard))
+	assert.Equal(t, []uint{1, 2}, matrixRanksOf(colleyOrdinal))
+	assert.Equal(t, []uint{1, 1}, matrixRanksOf(masseyStandard))
+	assert.Equal(t, []uint{1, 2}, matrixRanksOf(masseyOrdinal))
+}
+
+func assertMatrixRatings(t *testing.T, expected map[string]float64, actual []league.MatrixRating) {
+	assert.Len(t, actual, len(expected))
+	for i, rating := range actual {
+		assert.Equal(t, uint(i+1), rating.Rank)
+		assert.InDelta(t, expected[rating.Team], rating.Rating, 0.0001, rating.Team)
+	}
+}
+
+func matrixTeamsOf(ratings []league.MatrixRating) []string {
+	teams := make([]string, len(ratings))
+	for i, rating := range ratings {
+		teams[i] = rating.Team
+	}
+	return teams
+}
+
+func matrixRanksOf(ratings []league.MatrixRating) []uint {
+	ranks := make([]uint, len(ratings))
+	for i, rating := range ratings {
+		ranks[i] = rating.Rank
+	}
+	return ranks
+}
@@ -0,0 +1,365 @@
+package league
+
+import (
+	"math"
+	"sort"
+)
+
+// --- FitPoissonModel related ---
+
+// The Poisson model gives each team an attack and a defence strength, such
+// that in a game between team i and team j, team i's score is Poisson
+// distributed with mean:
+//
+//	average * attack_i * defence_j (* home advantage, if team i is at home)
+//
+// Dixon and Coles' adjustment then corrects the probabilities of the low
+// scorelines 0-0, 1-0, 0-1 and 1-1 by a dependence parameter rho, since these
+// are often more or less common than independent Poisson scores suggest.
+//
+// See Dixon and Coles (1997), "Modelling Association Football Scores and
+// Inefficiencies in the Football Betting Market".
+
+// PoissonOptions customise how a Poisson model is fitted.
+type PoissonOptions struct {
+	// Treat team A of each game as the home team, and fit a home advantage.
+	HomeAdvantage bool
+	// The number of virtual games at the league average which each team is
+	// assumed to have played. This keeps teams with few games from having
+	// extreme strengths, e.g. an attack of zero for a team yet to score.
+	Prior float64
+	// Iteration stops once no strength changes by more than this, or after
+	// this many iterations.
+	Tolerance     float64
+	MaxIterations int
+}
+
+// DefaultPoissonOptions are suitable for most leagues.
+func DefaultPoissonOptions() PoissonOptions {
+	return PoissonOptions{
+		Prior:         1,
+		Tolerance:     1e-9,
+		MaxIterations: 1000,
+	}
+}
+
+// PoissonModel is the result of fitting the Poisson model to game results.
+type PoissonModel struct {
+	// The average score of a team in a game.
+	Average float64
+	// Relative to an average team, which has an attack and defence of 1. A
+	// higher defence means more is conceded.
+	Attack  map[string]float64
+	Defence map[string]float64
+	// Multiplies the home team's expected score. 1 if not fitted.
+	HomeAdvantage float64
+	// Dixon and Coles' dependence parameter. Negative values make draws of
+	// 0-0 and 1-1 more likely.
+	Rho float64
+}
+
+// Scoreline is the probability of a game finishing with a given score.
+type Scoreline struct {
+	ScoreA      int
+	ScoreB      int
+	Probability float64
+}
+
+// Prediction is the outcome of a game according to a PoissonModel.
+type Prediction struct {
+	ExpectedA float64
+	ExpectedB float64
+	// From team A's side.
+	Win  float64
+	Draw float64
+	Loss float64
+	// Ordered from most to least likely.
+	Scorelines []Scoreline
+}
+
+// Scores more than this many standard deviations above the expected score are
+// treated as impossible when predicting, which loses a negligible amount of
+// probability.
+const predictedScoreDeviations = 10
+
+// Fit a Poisson model to game results, by maximum likelihood. Only played
+// games count, and negative scores count as zero.
+func FitPoissonModel(gameResults []GameResult, opts PoissonOptions) PoissonModel {
+	fit := newPoissonFit(gameResults)
+	model := PoissonModel{
+		Attack:        make(map[string]float64, len(fit.teams)),
+		Defence:       make(map[string]float64, len(fit.teams)),
+		HomeAdvantage: 1,
+	}
+	if len(fit.games) == 0 || fit.totalScored == 0 {
+		// Nothing to go on, so every team is average.
+		for _, team := range fit.teams {
+			model.Attack[team], model.Defence[team] = 1, 1
+		}
+		return model
+	}
+
+	model.Average = fit.totalScored / float64(2*len(fit.games))
+	attack, defence, home := fit.run(model.Average, opts)
+	for i, team := range fit.teams {
+		model.Attack[team], model.Defence[team] = attack[i], defence[i]
+	}
+	model.HomeAdvantage = home
+	model.Rho = fit.fitRho(model.Average, attack, defence, home)
+	return model
+}
+
+// Teams which the model does not know are assumed to be average.
+func (m PoissonModel) ExpectedScores(teamA string, teamB string, home bool) (float64, float64) {
+	expectedA := m.Average * m.factor(m.Attack, teamA) * m.factor(m.Defence, teamB)
+	expectedB := m.Average * m.factor(m.Attack, teamB) * m.factor(m.Defence, teamA)
+	if home {
+		expectedA *= m.HomeAdvantage
+	}
+	return expectedA, expectedB
+}
+
+func (m PoissonModel) factor(factors map[string]float64, team string) float64 {
+	if factor, ok := factors[team]; ok {
+		return factor
+	}
+	return 1
+}
+
+// Knows is whether the model was fitted with games involving the team.
+func (m PoissonModel) Knows(team string) bool {
+	_, ok := m.Attack[team]
+	return ok
+}
+
+// Predict the outcome of a game between team A and team B. If home is set,
+// team A is at home.
+func (m PoissonModel) Predict(teamA string, teamB string, home bool) Prediction {
+	expectedA, expectedB := m.ExpectedScores(teamA, teamB, home)
+	prediction := Prediction{ExpectedA: expectedA, ExpectedB: expectedB}
+
+	probabilitiesA := poissonProbabilities(expectedA)
+	probabilitiesB := poissonProbabilities(expectedB)
+	total := 0.0
+	for scoreA, probabilityA := range probabilitiesA {
+		for scoreB, probabilityB := range probabilitiesB {
+			probability := probabilityA * probabilityB * dixonColesTau(scoreA, scoreB, expectedA, expectedB, m.Rho)
+			prediction.Scorelines = append(prediction.Scorelines, Scoreline{scoreA, scoreB, probability})
+			total += probability
+		}
+	}
+
+	// Normalise, to account for the scores treated as impossible.
+	for i := range prediction.Scorelines {
+		scoreline := &prediction.Scorelines[i]
+		if total > 0 {
+			scoreline.Probability /= total
+		}
+		switch DetermineOutcome(scoreline.ScoreA, scoreline.ScoreB) {
+		case OutcomeWin:
+			prediction.Win += scoreline.Probability
+		case OutcomeDraw:
+			prediction.Draw += scoreline.Probability
+		default:
+			prediction.Loss += scoreline.Probability
+		}
+	}
+
+	sort.SliceStable(prediction.Scorelines, func(i int, j int) bool {
+		return prediction.Scorelines[i].Probability > prediction.Scorelines[j].Probability
+	})
+	return prediction
+}
+
+// The probability of each score, up to the most which are not treated as
+// impossible. The grid grows with the mean, e.g. for rugby and basketball.
+func poissonProbabilities(mean float64) []float64 {
+	maxScore := 0
+	if mean > 0 {
+		maxScore = int(math.Ceil(mean + predictedScoreDeviations*math.Sqrt(mean)))
+	}
+	probabilities := make([]float64, maxScore+1)
+	for k := range probabilities {
+		probabilities[k] = poissonProbability(k, mean)
+	}
+	return probabilities
+}
+
+func poissonProbability(k int, mean float64) float64 {
+	if mean <= 0 {
+		if k == 0 {
+			return 1
+		}
+		return 0
+	}
+	logP := float64(k)*math.Log(mean) - mean
+	for i := 2; i <= k; i++ {
+		logP -= math.Log(float64(i))
+	}
+	return math.Exp(logP)
+}
+
+func dixonColesTau(scoreA int, scoreB int, expectedA float64, expectedB float64, rho float64) float64 {
+	switch {
+	case scoreA == 0 && scoreB == 0:
+		return 1 - expectedA*expectedB*rho
+	case scoreA == 0 && scoreB == 1:
+		return 1 + expectedA*rho
+	case scoreA == 1 && scoreB == 0:
+		return 1 + expectedB*rho
+	case scoreA == 1 && scoreB == 1:
+		return 1 - rho
+	default:
+		return 1
+	}
+}
+
+type poissonGame struct {
+	a, b           int
+	scoreA, scoreB float64
+}
+
+type poissonFit struct {
+	// Sorted, so that iteration is deterministic.
+	teams       []string
+	games       []poissonGame
+	scored      []float64
+	conceded    []float64
+	played      []float64
+	totalScored float64
+	homeScored  float64
+}
+
+func newPoissonFit(gameResults []GameResult) *poissonFit {
+	teamSet := make(map[string]int)
+	for _, gameResult := range gameResults {
+		teamSet[gameResult.TeamA] = 0
+		teamSet[gameResult.TeamB] = 0
+	}
+	teams := make([]string, 0, len(teamSet))
+	for team := range teamSet {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+	for i, team := range teams {
+		teamSet[team] = i
+	}
+
+	fit := &poissonFit{
+		teams:    teams,
+		scored:   make([]float64, len(teams)),
+		conceded: make([]float64, len(teams)),
+		played:   make([]float64, len(teams)),
+	}
+	for _, gameResult := range gameResults {
+		if gameResult.Status != StatusPlayed {
+			continue
+		}
+		game := poissonGame{
+			a:      teamSet[gameResult.TeamA],
+			b:      teamSet[gameResult.TeamB],
+			scoreA: math.Max(float64(gameResult.ScoreA), 0),
+			scoreB: math.Max(float64(gameResult.ScoreB), 0),
+		}
+		fit.games = append(fit.games, game)
+		fit.scored[game.a] += game.scoreA
+		fit.scored[game.b] += game.scoreB
+		fit.conceded[game.a] += game.scoreB
+		fit.conceded[game.b] += game.scoreA
+		fit.played[game.a]++
+		fit.played[game.b]++
+		fit.totalScored += game.scoreA + game.scoreB
+		fit.homeScored += game.scoreA
+	}
+	return fit
+}
+
+// Alternates between fixed point updates of the attack strengths, the defence
+// strengths and the home advantage, each of which maximises the likelihood
+// given the others.
+func (f *poissonFit) run(average float64, opts PoissonOptions) (attack []float64, defence []float64, home float64) {
+	attack, defence, home = make([]float64, len(f.teams)), make([]float64, len(f.teams)), 1
+	for i := range f.teams {
+		attack[i], defence[i] = 1, 1
+	}
+
+	for iteration := 0; iteration < opts.MaxIterations; iteration++ {
+		maxChange := 0.0
+		update := func(values []float64, i int, value float64) {
+			maxChange = math.Max(maxChange, math.Abs(value-values[i]))
+			values[i] = value
+		}
+
+		// The prior's virtual games are against an average team, away from home.
+		attackExposure, defenceExposure := make([]float64, len(f.teams)), make([]float64, len(f.teams))
+		for _, game := range f.games {
+			attackExposure[game.a] += defence[game.b] * home
+			attackExposure[game.b] += defence[game.a]
+		}
+		for i := range f.teams {
+			update(attack, i, (f.scored[i]+opts.Prior*average)/(average*(attackExposure[i]+opts.Prior)))
+		}
+		for _, game := range f.games {
+			defenceExposure[game.b] += attack[game.a] * home
+			defenceExposure[game.a] += attack[game.b]
+		}
+		for i := range f.teams {
+			update(defence, i, (f.conceded[i]+opts.Prior*average)/(average*(defenceExposure[i]+opts.Prior)))
+		}
+
+		if opts.HomeAdvantage {
+			homeExposure := 0.0
+			for _, game := range f.games {
+				homeExposure += average * attack[game.a] * defence[game.b]
+			}
+			newHome := f.homeScored / homeExposure
+			maxChange = math.Max(maxChange, math.Abs(newHome-home))
+			home = newHome
+		}
+
+		if maxChange <= opts.Tolerance {
+			break
+		}
+	}
+	return attack, defence, home
+}
+
+// rho is fitted by maximising the likelihood with the strengths held fixed,
+// using a golden section search over the values which keep all scoreline
+// probabilities positive.
+func (f *poissonFit) fitRho(average float64, attack []float64, defence []float64, home float64) float64 {
+	type means struct{ a, b float64 }
+	gameMeans := make([]means, len(f.games))
+	low, high := -1.0, 1.0
+	for i, game := range f.games {
+		meanA := average * attack[game.a] * defence[game.b] * home
+		meanB := average * attack[game.b] * defence[game.a]
+		gameMeans[i] = means{meanA, meanB}
+		low = math.Max(low, math.Max(-1/meanA, -1/meanB))
+		high = math.Min(high, 1/(meanA*meanB))
+	}
+	// Stay just inside the bounds, where a probability would be zero.
+	margin := (high - low) * 1e-6
+	low, high = low+margin, high-margin
+
+	logLikelihood := func(rho float64) float64 {
+		sum := 0.0
+		for i, game := range f.games {
+			tau := dixonColesTau(int(game.scoreA), int(game.scoreB), gameMeans[i].a, gameMeans[i].b, rho)
+			sum += math.Log(tau)
+		}
+		return sum
+	}
+
+	invPhi := (math.Sqrt(5) - 1) / 2
+	for high-low > 1e-9 {
+		c := high - invPhi*(high-low)
+		d := low + invPhi*(high-low)
+		if logLikelihood(c) > logLikelihood(d) {
+			high = d
+		} else {
+			low = c
+		}
+	}
+	return (low + high) / 2
+}
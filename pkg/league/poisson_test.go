@@ -0,0 +1,185 @@
+package league_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func poissonGameResultsFixture() []league.GameResult {
+	return []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Snakes", ScoreA: 0, TeamB: "Tarantulas", ScoreB: 2},
+		{TeamA: "Tarantulas", ScoreA: 1, TeamB: "Lions", ScoreB: 1},
+		{TeamA: "Lions", ScoreA: 2, TeamB: "Tarantulas", ScoreB: 0},
+		{TeamA: "Snakes", ScoreA: 2, TeamB: "Lions", ScoreB: 2},
+		{TeamA: "Tarantulas", ScoreA: 4, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Grouches", TeamB: "Lions", Status: league.StatusAwardedA},
+	}
+}
+
+func TestFitPoissonModel_GivenNoPrior_ShouldMatchTotals(t *testing.T) {
+	// Setup fixture
+	// -> At the maximum likelihood, each team's expected scores for and
+	//    against, over the games played, match the actual totals.
+	gameResultsFixture := poissonGameResultsFixture()
+	optsFixture := league.DefaultPoissonOptions()
+	optsFixture.Prior = 0
+	optsFixture.HomeAdvantage = true
+
+	// Exercise SUT
+	actual := league.FitPoissonModel(gameResultsFixture, optsFixture)
+
+	// Verify results
+	assert.InDelta(t, 19.0/12, actual.Average, 1e-9)
+	scored, conceded := map[string]float64{}, map[string]float64{}
+	expectedScored, expectedConceded := map[string]float64{}, map[string]float64{}
+	homeScored, expectedHomeScored := 0.0, 0.0
+	for _, gameResult := range gameResultsFixture {
+		if gameResult.Status != league.StatusPlayed {
+			continue
+		}
+		expectedA, expectedB := actual.ExpectedScores(gameResult.TeamA, gameResult.TeamB, true)
+		scored[gameResult.TeamA] += float64(gameResult.ScoreA)
+		scored[gameResult.TeamB] += float64(gameResult.ScoreB)
+		conceded[gameResult.TeamA] += float64(gameResult.ScoreB)
+		conceded[gameResult.TeamB] += float64(gameResult.ScoreA)
+		expectedScored[gameResult.TeamA] += expectedA
+		expectedScored[gameResult.TeamB] += expectedB
+		expectedConceded[gameResult.TeamA] += expectedB
+		expectedConceded[gameResult.TeamB] += expectedA
+		homeScored += float64(gameResult.ScoreA)
+		expectedHomeScored += expectedA
+	}
+	for team := range scored {
+		assert.InDelta(t, scored[team], expectedScored[team], 1e-6, team)
+		assert.InDelta(t, conceded[team], expectedConceded[team], 1e-6, team)
+	}
+	assert.InDelta(t, homeScored, expectedHomeScored, 1e-6)
+	assert.Greater(t, actual.HomeAdvantage, 1.0)
+}
+
+func TestFitPoissonModel_GivenPrior_ShouldShrinkTowardsAverage(t *testing.T) {
+	// Setup fixture
+	withoutPriorFixture := league.DefaultPoissonOptions()
+	withoutPriorFixture.Prior = 0
+
+	// Exercise SUT
+	withPrior := league.FitPoissonModel(poissonGameResultsFixture(), league.DefaultPoissonOptions())
+	withoutPrior := league.FitPoissonModel(poissonGameResultsFixture(), withoutPriorFixture)
+
+	// Verify results
+	assert.Less(t, withPrior.Attack["Lions"], withoutPrior.Attack["Lions"])
+	assert.Greater(t, withPrior.Attack["Lions"], 1.0)
+	// -> Grouches have only played a walkover, so are average.
+	assert.True(t, withPrior.Knows("Grouches"))
+	assert.InDelta(t, 1, withPrior.Attack["Grouches"], 1e-9)
+	assert.InDelta(t, 1, withPrior.Defence["Grouches"], 1e-9)
+	assert.Equal(t, 1.0, withPrior.HomeAdvantage)
+}
+
+func TestFitPoissonModel_GivenLowScoringDraws_ShouldFitNegativeRho(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 0, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Snakes", ScoreA: 1, TeamB: "Lions", ScoreB: 1},
+		{TeamA: "Lions", ScoreA: 0, TeamB: "Snakes", ScoreB: 0},
+		{TeamA: "Snakes", ScoreA: 3, TeamB: "Lions", ScoreB: 2},
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 2},
+	}
+
+	// Exercise SUT
+	actual := league.FitPoissonModel(gameResultsFixture, league.DefaultPoissonOptions())
+
+	// Verify results
+	assert.Less(t, actual.Rho, 0.0)
+}
+
+func TestFitPoissonModel_GivenNoScores_ShouldBeAverage(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 0, TeamB: "Snakes", ScoreB: 0},
+	}
+
+	// Exercise SUT
+	actual := league.FitPoissonModel(gameResultsFixture, league.DefaultPoissonOptions())
+
+	// Verify results
+	assert.Equal(t, 0.0, actual.Average)
+	assert.Equal(t, map[string]float64{"Lions": 1, "Snakes": 1}, actual.Attack)
+	prediction := actual.Predict("Lions", "Snakes", false)
+	assert.Equal(t, 1.0, prediction.Draw)
+	assert.Equal(t, league.Scoreline{ScoreA: 0, ScoreB: 0, Probability: 1}, prediction.Scorelines[0])
+}
+
+func TestPoissonModel_Predict_GivenIndependentScores(t *testing.T) {
+	// Setup fixture
+	modelFixture := league.PoissonModel{
+		Average:       1,
+		Attack:        map[string]float64{"Lions": 2, "Snakes": 1},
+		Defence:       map[string]float64{"Lions": 1, "Snakes": 0.5},
+		HomeAdvantage: 1.5,
+	}
+
+	// Exercise SUT
+	actual := modelFixture.Predict("Lions", "Snakes", false)
+	home := modelFixture.Predict("Lions", "Snakes", true)
+
+	// Verify results
+	assert.InDelta(t, 1, actual.ExpectedA, 1e-9)
+	assert.InDelta(t, 1, actual.ExpectedB, 1e-9)
+	assert.InDelta(t, 1.5, home.ExpectedA, 1e-9)
+	// -> With equal expected scores of 1, a draw has probability e^-2 I0(2).
+	assert.InDelta(t, 0.30851, actual.Draw, 1e-5)
+	assert.InDelta(t, actual.Win, actual.Loss, 1e-9)
+	assert.InDelta(t, 1, actual.Win+actual.Draw+actual.Loss, 1e-9)
+	assert.InDelta(t, math.Exp(-2), actual.Scorelines[0].Probability, 1e-6)
+	for i := 1; i < len(actual.Scorelines); i++ {
+		assert.GreaterOrEqual(t, actual.Scorelines[i-1].Probability, actual.Scorelines[i].Probability)
+	}
+	assert.Greater(t, home.Win, actual.Win)
+}
+
+func TestPoissonModel_Predict_GivenHighScores_ShouldKeepExpectedScores(t *testing.T) {
+	// Setup fixture
+	modelFixture := league.PoissonModel{
+		Average: 1,
+		Attack:  map[string]float64{"Lions": 24.87, "Snakes": 21.76},
+	}
+
+	// Exercise SUT
+	actual := modelFixture.Predict("Lions", "Snakes", false)
+
+	// Verify results
+	// -> E.g. rugby, where scores well above 15 are common.
+	meanA, meanB := 0.0, 0.0
+	for _, scoreline := range actual.Scorelines {
+		meanA += float64(scoreline.ScoreA) * scoreline.Probability
+		meanB += float64(scoreline.ScoreB) * scoreline.Probability
+	}
+	assert.InDelta(t, 24.87, meanA, 1e-6)
+	assert.InDelta(t, 21.76, meanB, 1e-6)
+	assert.InDelta(t, 1, actual.Win+actual.Draw+actual.Loss, 1e-9)
+	assert.Greater(t, actual.Win, actual.Loss)
+}
+
+func TestPoissonModel_Predict_GivenRho_ShouldAdjustLowScores(t *testing.T) {
+	// Setup fixture
+	modelFixture := league.PoissonModel{Average: 1, Rho: -0.1}
+
+	// Exercise SUT
+	actual := modelFixture.Predict("Lions", "Snakes", false)
+
+	// Verify results
+	// -> 0-0 and 1-1 are each 10% more likely, 1-0 and 0-1 10% less likely.
+	probabilities := map[[2]int]float64{}
+	for _, scoreline := range actual.Scorelines {
+		probabilities[[2]int{scoreline.ScoreA, scoreline.ScoreB}] = scoreline.Probability
+	}
+	assert.InDelta(t, 1.1*math.Exp(-2), probabilities[[2]int{0, 0}], 1e-6)
+	assert.InDelta(t, 1.1*math.Exp(-2), probabilities[[2]int{1, 1}], 1e-6)
+	assert.InDelta(t, 0.9*math.Exp(-2), probabilities[[2]int{1, 0}], 1e-6)
+	assert.InDelta(t, 1, actual.Win+actual.Draw+actual.Loss, 1e-9)
+}
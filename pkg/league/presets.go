@@ -0,0 +1,96 @@
+package league
+
+import "sort"
+
+// Preset is a built-in scoring system for a sport.
+type Preset struct {
+	Name        string
+	Description string
+	Options     Options
+}
+
+// Built fresh on each call, so callers can't modify the presets.
+func presets() []Preset {
+	return []Preset{
+		{
+			Name:        "football",
+			Description: "3 points for a win, 1 for a draw, 0 for a loss.",
+			Options:     DefaultOptions(),
+		},
+		{
+			Name:        "hockey",
+			Description: "2 points for a win, 1 for a tie or a loss in overtime or a shootout, 0 for a loss.",
+			Options:     hockeyOptions(),
+		},
+		{
+			Name:        "basketball",
+			Description: "Ranked by win percentage.",
+			Options: Options{
+				Scoring: pointsOptions(1, 0, 0).Scoring,
+				Metric:  MetricWinPercentage,
+			},
+		},
+		{
+			Name:        "chess",
+			Description: "1 point for a win, 1/2 for a draw, 0 for a loss.",
+			Options:     pointsOptions(1, 0.5, 0),
+		},
+		{
+			Name: "rugby-union",
+			Description: "4 points for a win, 2 for a draw, 0 for a loss, " +
+				"plus 1 for losing by 7 or fewer.",
+			// The try bonus can't be included, since scores are points rather than tries.
+			// A rules file which treats scores as tries can add it.
+			Options: rugbyUnionOptions(),
+		},
+	}
+}
+
+// Find the preset with the given name.
+func LookupPreset(name string) (Preset, bool) {
+	for _, preset := range presets() {
+		if preset.Name == name {
+			return preset, true
+		}
+	}
+	return Preset{}, false
+}
+
+// The names of all presets, in alphabetical order.
+func PresetNames() []string {
+	allPresets := presets()
+	names := make([]string, len(allPresets))
+	for i, preset := range allPresets {
+		names[i] = preset.Name
+	}
+	sort.Strings(names)
+	return names
+}
+
+func pointsOptions(win float64, draw float64, lose float64) Options {
+	return Options{
+		Scoring: Scoring{
+			WinPoints:         win,
+			DrawPoints:        draw,
+			LosePoints:        lose,
+			ForfeitWinPoints:  win,
+			ForfeitLosePoints: lose,
+		},
+	}
+}
+
+func hockeyOptions() Options {
+	opts := pointsOptions(2, 1, 0)
+	opts.Scoring.Overtime = &DecisionPoints{WinPoints: 2, LosePoints: 1}
+	opts.Scoring.Shootout = &DecisionPoints{WinPoints: 2, LosePoints: 1}
+	return opts
+}
+
+func rugbyUnionOptions() Options {
+	opts := pointsOptions(4, 2, 0)
+	losingMargin := -7
+	opts.Scoring.Bonuses = []BonusRule{
+		{Name: "losing bonus", Points: 1, Outcome: OutcomeLoss, MinMargin: &losingMargin},
+	}
+	return opts
+}
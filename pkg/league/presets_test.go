@@ -0,0 +1,122 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresetNames(t *testing.T) {
+	// Exercise SUT
+	actual := league.PresetNames()
+
+	// Verify results
+	assert.Equal(t, []string{"basketball", "chess", "football", "hockey", "rugby-union"}, actual)
+}
+
+func TestLookupPreset_GivenUnknownName_ShouldFail(t *testing.T) {
+	// Exercise SUT
+	_, ok := league.LookupPreset("quidditch")
+
+	// Verify results
+	assert.False(t, ok)
+}
+
+func TestLookupPreset_GivenChess_ShouldAwardHalfPointsForDraws(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Carlsen", ScoreA: 1, TeamB: "Nakamura", ScoreB: 1},
+		{TeamA: "Carlsen", ScoreA: 1, TeamB: "Caruana", ScoreB: 0},
+	}
+
+	// Setup expectations
+	rankingsExpected := []league.Ranking{
+		{Rank: 1, Team: "Carlsen", Points: 1.5, Played: 2, Won: 1, Drawn: 1},
+		{Rank: 2, Team: "Nakamura", Points: 0.5, Played: 1, Drawn: 1},
+		{Rank: 3, Team: "Caruana", Points: 0, Played: 1, Lost: 1},
+	}
+
+	// Exercise SUT
+	preset, ok := league.LookupPreset("chess")
+	rankingsActual := league.CalculateRankingsWithOptions(gameResultsFixture, preset.Options)
+
+	// Verify results
+	assert.True(t, ok)
+	assert.Equal(t, rankingsExpected, rankingsActual)
+}
+
+func TestLookupPreset_GivenBasketball_ShouldRankByWinPercentage(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Bulls", ScoreA: 101, TeamB: "Celtics", ScoreB: 99},
+		{TeamA: "Bulls", ScoreA: 90, TeamB: "Lakers", ScoreB: 95},
+		{TeamA: "Lakers", ScoreA: 88, TeamB: "Celtics", ScoreB: 80},
+		{TeamA: "Lakers", ScoreA: 70, TeamB: "Celtics", ScoreB: 100},
+		{TeamA: "Heat", ScoreA: 120, TeamB: "Celtics", ScoreB: 100},
+	}
+
+	// Exercise SUT
+	preset, ok := league.LookupPreset("basketball")
+	rankingsActual := league.CalculateRankingsWithOptions(gameResultsFixture, preset.Options)
+
+	// Verify results
+	assert.True(t, ok)
+	assert.Equal(t, []string{"Heat", "Lakers", "Bulls", "Celtics"}, teamsOf(rankingsActual))
+	assert.Equal(t, []uint{1, 2, 3, 4}, ranksOf(rankingsActual))
+	assert.InDelta(t, 2.0/3.0, rankingsActual[1].WinPercentage(), 1e-9)
+	assert.InDelta(t, 0.25, rankingsActual[3].WinPercentage(), 1e-9)
+}
+
+func TestLookupPreset_GivenRugbyUnion_ShouldAwardLosingBonus(t *testing.T) {
+	// Setup fixture
+	preset, _ := league.LookupPreset("rugby-union")
+
+	// Exercise SUT
+	pointsAActual, pointsBActual := preset.Options.Scoring.AssignPoints(league.GameResult{
+		TeamA: "Sharks", ScoreA: 20, TeamB: "Stormers", ScoreB: 27,
+	})
+
+	// Verify results
+	assert.Equal(t, 1.0, pointsAActual)
+	assert.Equal(t, 4.0, pointsBActual)
+}
+
+func TestLookupPreset_GivenHockey_ShouldAwardPointForOvertimeLoss(t *testing.T) {
+	// Setup fixture
+	preset, _ := league.LookupPreset("hockey")
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Bruins", ScoreA: 3, TeamB: "Canadiens", ScoreB: 2, Decision: league.DecisionOvertime},
+		{TeamA: "Bruins", ScoreA: 1, TeamB: "Rangers", ScoreB: 2, Decision: league.DecisionShootout},
+		{TeamA: "Rangers", ScoreA: 0, TeamB: "Canadiens", ScoreB: 4},
+	}
+
+	// Setup expectations
+	rankingsExpected := []league.Ranking{
+		{Rank: 1, Team: "Bruins", Points: 3, Played: 2, Won: 1, Lost: 1},
+		{Rank: 1, Team: "Canadiens", Points: 3, Played: 2, Won: 1, Lost: 1},
+		{Rank: 3, Team: "Rangers", Points: 2, Played: 2, Won: 1, Lost: 1},
+	}
+
+	// Exercise SUT
+	rankingsActual := league.CalculateRankingsWithOptions(gameResultsFixture, preset.Options)
+
+	// Verify results
+	assert.Equal(t, rankingsExpected, rankingsActual)
+}
+
+func teamsOf(rankings []league.Ranking) []string {
+	teams := make([]string, len(rankings))
+	for i, ranking := range rankings {
+		teams[i] = ranking.Team
+	}
+	return teams
+}
+
+func ranksOf(rankings []league.Ranking) []uint {
+	ranks := make([]uint, len(rankings))
+	for i, ranking := range rankings {
+		ranks[i] = ranking.Rank
+	}
+	return ranks
+}
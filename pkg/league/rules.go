@@ -0,0 +1,76 @@
+package league
+
+// Outcome is the result of a game from the perspective of one team.
+type Outcome uint8
+
+const (
+	// Matches any outcome, when used as a condition.
+	OutcomeAny Outcome = iota
+	OutcomeWin
+	OutcomeDraw
+	OutcomeLoss
+)
+
+// Determine the outcome for a team given its score and its opponent's score.
+func DetermineOutcome(score int, opponentScore int) Outcome {
+	if score == opponentScore {
+		return OutcomeDraw
+	}
+	if score > opponentScore {
+		return OutcomeWin
+	}
+	return OutcomeLoss
+}
+
+// Determine the outcome of a game for A and B. Awarded games are a win for the
+// awarded team, regardless of scores. Abandoned games have no meaningful outcome.
+func (gr GameResult) Outcomes() (outcomeA Outcome, outcomeB Outcome) {
+	switch gr.Status {
+	case StatusAwardedA:
+		return OutcomeWin, OutcomeLoss
+	case StatusAwardedB:
+		return OutcomeLoss, OutcomeWin
+	default:
+		return DetermineOutcome(gr.ScoreA, gr.ScoreB), DetermineOutcome(gr.ScoreB, gr.ScoreA)
+	}
+}
+
+// BonusRule awards extra points to a team when all of its conditions are met
+// for a played game. Nil thresholds are not checked.
+type BonusRule struct {
+	// Describes the rule, e.g. "losing bonus".
+	Name   string
+	Points float64
+
+	// The outcome the team must achieve.
+	Outcome Outcome
+	// Bounds (inclusive) on the team's own score.
+	MinScore *int
+	MaxScore *int
+	// Bounds (inclusive) on the team's score minus its opponent's score, so a
+	// loss by 7 has a margin of -7.
+	MinMargin *int
+	MaxMargin *int
+}
+
+// Determine if the rule applies to a team given its score and its opponent's
+// score.
+func (br BonusRule) Applies(score int, opponentScore int) bool {
+	if br.Outcome != OutcomeAny && br.Outcome != DetermineOutcome(score, opponentScore) {
+		return false
+	}
+
+	margin := score - opponentScore
+	return withinBounds(score, br.MinScore, br.MaxScore) &&
+		withinBounds(margin, br.MinMargin, br.MaxMargin)
+}
+
+func withinBounds(value int, min *int, max *int) bool {
+	if min != nil && value < *min {
+		return false
+	}
+	if max != nil && value > *max {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,166 @@
+package league_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoring_AssignPoints_GivenBonuses(t *testing.T) {
+	// Setup fixture and expectations
+	scoringFixture := rugbyScoring()
+	cases := []struct {
+		scoreAFixture   int
+		scoreBFixture   int
+		pointsAExpected float64
+		pointsBExpected float64
+	}{
+		// No bonuses
+		{
+			3, 20,
+			0, 4,
+		},
+		{
+			0, 0,
+			2, 2,
+		},
+		// Losing bonus only
+		{
+			10, 17,
+			1, 4,
+		},
+		// Score bonus for both, losing bonus for B
+		{
+			30, 25,
+			5, 2,
+		},
+		// Score bonus for a draw
+		{
+			28, 28,
+			3, 3,
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %d", i), func(t *testing.T) {
+			// Exercise SUT
+			pointsAActual, pointsBActual := scoringFixture.AssignPoints(league.GameResult{
+				TeamA: "A", ScoreA: c.scoreAFixture, TeamB: "B", ScoreB: c.scoreBFixture,
+			})
+
+			// Verify results
+			assert.Equal(t, c.pointsAExpected, pointsAActual)
+			assert.Equal(t, c.pointsBExpected, pointsBActual)
+		})
+	}
+}
+
+func TestScoring_AssignPoints_GivenBonusesForAwardedGame_ShouldNotApplyBonuses(t *testing.T) {
+	// Setup fixture
+	scoringFixture := rugbyScoring()
+	gameResultFixture := league.GameResult{TeamA: "A", TeamB: "B", Status: league.StatusAwardedB}
+
+	// Exercise SUT
+	pointsAActual, pointsBActual := scoringFixture.AssignPoints(gameResultFixture)
+
+	// Verify results
+	assert.Equal(t, float64(league.LosePoints), pointsAActual)
+	assert.Equal(t, float64(league.WinPoints), pointsBActual)
+}
+
+func TestScoring_AssignPoints_GivenDecisions(t *testing.T) {
+	// Setup fixture and expectations
+	scoringFixture := league.DefaultScoring()
+	scoringFixture.Overtime = &league.DecisionPoints{WinPoints: 2, LosePoints: 1}
+	cases := []struct {
+		gameResultFixture league.GameResult
+		pointsAExpected   float64
+		pointsBExpected   float64
+	}{
+		// Regulation
+		{
+			league.GameResult{TeamA: "A", ScoreA: 3, TeamB: "B", ScoreB: 2},
+			3, 0,
+		},
+		// Overtime uses the overtime points
+		{
+			league.GameResult{TeamA: "A", ScoreA: 3, TeamB: "B", ScoreB: 2, Decision: league.DecisionOvertime},
+			2, 1,
+		},
+		{
+			league.GameResult{TeamA: "A", ScoreA: 2, TeamB: "B", ScoreB: 3, Decision: league.DecisionOvertime},
+			1, 2,
+		},
+		// Shootout falls back to regulation points, since none are configured
+		{
+			league.GameResult{TeamA: "A", ScoreA: 2, TeamB: "B", ScoreB: 3, Decision: league.DecisionShootout},
+			0, 3,
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %d", i), func(t *testing.T) {
+			// Exercise SUT
+			pointsAActual, pointsBActual := scoringFixture.AssignPoints(c.gameResultFixture)
+
+			// Verify results
+			assert.Equal(t, c.pointsAExpected, pointsAActual)
+			assert.Equal(t, c.pointsBExpected, pointsBActual)
+		})
+	}
+}
+
+func TestBonusRule_Applies(t *testing.T) {
+	// Setup fixture and expectations
+	cases := []struct {
+		ruleFixture          league.BonusRule
+		scoreFixture         int
+		opponentScoreFixture int
+		expected             bool
+	}{
+		// No conditions
+		{league.BonusRule{}, 0, 5, true},
+		// Outcome conditions
+		{league.BonusRule{Outcome: league.OutcomeWin}, 2, 1, true},
+		{league.BonusRule{Outcome: league.OutcomeWin}, 1, 1, false},
+		{league.BonusRule{Outcome: league.OutcomeDraw}, 1, 1, true},
+		{league.BonusRule{Outcome: league.OutcomeLoss}, 1, 1, false},
+		// Score bounds
+		{league.BonusRule{MinScore: intPtr(4), MaxScore: intPtr(6)}, 3, 0, false},
+		{league.BonusRule{MinScore: intPtr(4), MaxScore: intPtr(6)}, 4, 0, true},
+		{league.BonusRule{MinScore: intPtr(4), MaxScore: intPtr(6)}, 6, 0, true},
+		{league.BonusRule{MinScore: intPtr(4), MaxScore: intPtr(6)}, 7, 0, false},
+		// Margin bounds
+		{league.BonusRule{MinMargin: intPtr(-2), MaxMargin: intPtr(2)}, 0, 3, false},
+		{league.BonusRule{MinMargin: intPtr(-2), MaxMargin: intPtr(2)}, 1, 3, true},
+		{league.BonusRule{MinMargin: intPtr(-2), MaxMargin: intPtr(2)}, 3, 1, true},
+		{league.BonusRule{MinMargin: intPtr(-2), MaxMargin: intPtr(2)}, 4, 1, false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %d", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := c.ruleFixture.Applies(c.scoreFixture, c.opponentScoreFixture)
+
+			// Verify results
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func rugbyScoring() league.Scoring {
+	scoring := league.DefaultScoring()
+	scoring.WinPoints = 4
+	scoring.DrawPoints = 2
+	scoring.Bonuses = []league.BonusRule{
+		{Name: "score bonus", Points: 1, MinScore: intPtr(25)},
+		{Name: "losing bonus", Points: 1, Outcome: league.OutcomeLoss, MinMargin: intPtr(-7)},
+	}
+	return scoring
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,75 @@
+package league
+
+// --- CalculateStrengthOfSchedule related ---
+
+// StrengthOfSchedule describes how strong a team's opponents were, by some
+// value of each team such as points per game or a rating.
+type StrengthOfSchedule struct {
+	// The average value of the opponents faced, per game.
+	Opponents float64
+	// The average of the opponents' own Opponents, per game. This tells apart
+	// opponents who earned their value against strong teams from those who
+	// earned it against weak ones.
+	OpponentsOpponents float64
+}
+
+// Determine the strength of schedule of all the teams in a league given game
+// results, and a value for each team. Teams without a value count as zero.
+// Abandoned games are ignored, and a team which faced the same opponent twice
+// counts them twice.
+func CalculateStrengthOfSchedule(
+	gameResults []GameResult,
+	teamValues map[string]float64,
+) map[string]StrengthOfSchedule {
+	teamsToOpponents := make(map[string][]string)
+	for _, gameResult := range gameResults {
+		// Teams which only had abandoned games should still be included.
+		if gameResult.Status == StatusAbandoned {
+			for _, team := range []string{gameResult.TeamA, gameResult.TeamB} {
+				if _, ok := teamsToOpponents[team]; !ok {
+					teamsToOpponents[team] = nil
+				}
+			}
+			continue
+		}
+		teamsToOpponents[gameResult.TeamA] = append(teamsToOpponents[gameResult.TeamA], gameResult.TeamB)
+		teamsToOpponents[gameResult.TeamB] = append(teamsToOpponents[gameResult.TeamB], gameResult.TeamA)
+	}
+
+	teamsToOpponentValues := make(map[string]float64, len(teamsToOpponents))
+	for team, opponents := range teamsToOpponents {
+		teamsToOpponentValues[team] = averageOver(opponents, teamValues)
+	}
+
+	teamsToSchedules := make(map[string]StrengthOfSchedule, len(teamsToOpponents))
+	for team, opponents := range teamsToOpponents {
+		teamsToSchedules[team] = StrengthOfSchedule{
+			Opponents:          teamsToOpponentValues[team],
+			OpponentsOpponents: averageOver(opponents, teamsToOpponentValues),
+		}
+	}
+	return teamsToSchedules
+}
+
+func averageOver(teams []string, teamValues map[string]float64) float64 {
+	if len(teams) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, team := range teams {
+		sum += teamValues[team]
+	}
+	return sum / float64(len(teams))
+}
+
+// The points of each team per game played, including adjustments. Zero for
+// teams which have not played.
+func PointsPerGame(rankings []Ranking) map[string]float64 {
+	teamsToValues := make(map[string]float64, len(rankings))
+	for _, ranking := range rankings {
+		if ranking.Played > 0 {
+			teamsToValues[ranking.Team] = ranking.Points / float64(ranking.Played)
+		}
+	}
+	return teamsToValues
+}
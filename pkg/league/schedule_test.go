@@ -0,0 +1,63 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateStrengthOfSchedule(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 3},
+		{TeamA: "Tarantulas", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 1},
+		{TeamA: "Tarantulas", ScoreA: 3, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Lions", ScoreA: 4, TeamB: "Grouches", ScoreB: 0},
+		{TeamA: "Grouches", ScoreA: 2, TeamB: "Tarantulas", ScoreB: 0, Status: league.StatusAbandoned},
+		{TeamA: "Bears", ScoreA: 2, TeamB: "Tarantulas", ScoreB: 0, Status: league.StatusAbandoned},
+	}
+	teamValuesFixture := map[string]float64{
+		"Lions":      5,
+		"Tarantulas": 6,
+		"FC Awesome": 1,
+		"Snakes":     1,
+	}
+
+	// Setup expectations
+	// -> Lions faced Snakes, FC Awesome and Grouches: (1 + 1 + 0) / 3.
+	//    Their opponents' opponents are then (5.5 + 5.5 + 5) / 3.
+	expected := map[string]league.StrengthOfSchedule{
+		"Lions":      {Opponents: 2.0 / 3, OpponentsOpponents: 16.0 / 3},
+		"Tarantulas": {Opponents: 1, OpponentsOpponents: 5.5},
+		"FC Awesome": {Opponents: 5.5, OpponentsOpponents: (1 + 2.0/3) / 2},
+		"Snakes":     {Opponents: 5.5, OpponentsOpponents: (1 + 2.0/3) / 2},
+		"Grouches":   {Opponents: 5, OpponentsOpponents: 2.0 / 3},
+		"Bears":      {},
+	}
+
+	// Exercise SUT
+	actual := league.CalculateStrengthOfSchedule(gameResultsFixture, teamValuesFixture)
+
+	// Verify results
+	assert.Len(t, actual, len(expected))
+	for team, schedule := range expected {
+		assert.InDelta(t, schedule.Opponents, actual[team].Opponents, 1e-9, team)
+		assert.InDelta(t, schedule.OpponentsOpponents, actual[team].OpponentsOpponents, 1e-9, team)
+	}
+}
+
+func TestPointsPerGame(t *testing.T) {
+	// Setup fixture
+	rankingsFixture := []league.Ranking{
+		{Team: "Lions", Points: 5, Played: 3},
+		{Team: "Grouches", Points: -1, Played: 0},
+	}
+
+	// Exercise SUT
+	actual := league.PointsPerGame(rankingsFixture)
+
+	// Verify results
+	assert.Equal(t, map[string]float64{"Lions": 5.0 / 3}, actual)
+}
@@ -0,0 +1,228 @@
+package league
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// --- SimulateSeasons related ---
+
+// Fixture is a game which is yet to be played.
+type Fixture struct {
+	TeamA string
+	TeamB string
+}
+
+// SimulationOptions customise how the rest of a season is simulated.
+type SimulationOptions struct {
+	// How each simulated season is ranked.
+	Options Options
+	// How many seasons to simulate.
+	Seasons int
+	// Seasons are reproducible for the same seed, regardless of Workers.
+	Seed int64
+	// How many seasons to simulate in parallel. Zero means one per CPU.
+	Workers int
+	// How the model of each team's scoring is fitted to the games played.
+	Poisson PoissonOptions
+}
+
+// DefaultSimulationOptions are suitable for most leagues.
+func DefaultSimulationOptions() SimulationOptions {
+	return SimulationOptions{
+		Options: DefaultOptions(),
+		Seasons: 10000,
+		Seed:    1,
+		Poisson: DefaultPoissonOptions(),
+	}
+}
+
+// PositionProbabilities are the probabilities of a team finishing in each
+// position.
+type PositionProbabilities struct {
+	Team string
+	// Positions[i] is the probability of finishing in position i+1. Teams
+	// which finish level share the positions they span equally.
+	Positions []float64
+}
+
+// The average finishing position, weighted by probability.
+func (pp PositionProbabilities) ExpectedPosition() float64 {
+	expected := 0.0
+	for i, probability := range pp.Positions {
+		expected += float64(i+1) * probability
+	}
+	return expected
+}
+
+// Seasons are simulated in chunks of this size, so that results do not depend
+// on how the chunks are spread across workers.
+const simulationChunkSize = 250
+
+// Estimate the probabilities of each team finishing in each position, given
+// the games played so far and the fixtures remaining. Each remaining game's
+// score is sampled from a Poisson distribution, using a PoissonModel fitted
+// to the games played so far. Results are ordered by expected position.
+func SimulateSeasons(played []GameResult, fixtures []Fixture, opts SimulationOptions) []PositionProbabilities {
+	teams := simulationTeams(played, fixtures)
+	if len(teams) == 0 || opts.Seasons <= 0 {
+		return nil
+	}
+	teamsToIndices := make(map[string]int, len(teams))
+	for i, team := range teams {
+		teamsToIndices[team] = i
+	}
+	model := FitPoissonModel(played, opts.Poisson)
+	// Level teams share the positions they span, whatever the ranking style.
+	rankingOpts := opts.Options
+	rankingOpts.RankingStyle = RankingStyleStandard
+
+	// Each chunk gets its own tallies, which are summed in order at the end.
+	chunks := (opts.Seasons + simulationChunkSize - 1) / simulationChunkSize
+	chunkTallies := make([][][]float64, chunks)
+	chunkIdxs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < simulationWorkers(opts.Workers, chunks); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range chunkIdxs {
+				tallies := newTallies(len(teams))
+				first := chunk * simulationChunkSize
+				last := minInt(first+simulationChunkSize, opts.Seasons)
+				for season := first; season < last; season++ {
+					rng := rand.New(rand.NewSource(seasonSeed(opts.Seed, season)))
+					simulated := simulateFixtures(rng, model, fixtures, opts.Poisson.HomeAdvantage)
+					rankings := CalculateRankingsWithOptions(append(append([]GameResult{}, played...), simulated...), rankingOpts)
+					tallyPositions(tallies, rankings, teamsToIndices)
+				}
+				chunkTallies[chunk] = tallies
+			}
+		}()
+	}
+	for chunk := 0; chunk < chunks; chunk++ {
+		chunkIdxs <- chunk
+	}
+	close(chunkIdxs)
+	wg.Wait()
+
+	totals := newTallies(len(teams))
+	for _, tallies := range chunkTallies {
+		for i := range tallies {
+			for j := range tallies[i] {
+				totals[i][j] += tallies[i][j]
+			}
+		}
+	}
+
+	results := make([]PositionProbabilities, len(teams))
+	for i, team := range teams {
+		positions := make([]float64, len(teams))
+		for j, tally := range totals[i] {
+			positions[j] = tally / float64(opts.Seasons)
+		}
+		results[i] = PositionProbabilities{Team: team, Positions: positions}
+	}
+	sort.SliceStable(results, func(i int, j int) bool {
+		return results[i].ExpectedPosition() < results[j].ExpectedPosition()
+	})
+	return results
+}
+
+func simulationTeams(played []GameResult, fixtures []Fixture) []string {
+	teamSet := make(map[string]bool)
+	for _, gameResult := range played {
+		teamSet[gameResult.TeamA] = true
+		teamSet[gameResult.TeamB] = true
+	}
+	for _, fixture := range fixtures {
+		teamSet[fixture.TeamA] = true
+		teamSet[fixture.TeamB] = true
+	}
+	teams := make([]string, 0, len(teamSet))
+	for team := range teamSet {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+	return teams
+}
+
+func simulationWorkers(workers int, chunks int) int {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return minInt(workers, chunks)
+}
+
+// If home is set, team A of each fixture is at home.
+func simulateFixtures(rng *rand.Rand, model PoissonModel, fixtures []Fixture, home bool) []GameResult {
+	simulated := make([]GameResult, len(fixtures))
+	for i, fixture := range fixtures {
+		rateA, rateB := model.ExpectedScores(fixture.TeamA, fixture.TeamB, home)
+		simulated[i] = GameResult{
+			TeamA:  fixture.TeamA,
+			ScoreA: samplePoisson(rng, rateA),
+			TeamB:  fixture.TeamB,
+			ScoreB: samplePoisson(rng, rateB),
+		}
+	}
+	return simulated
+}
+
+func newTallies(teams int) [][]float64 {
+	tallies := make([][]float64, teams)
+	for i := range tallies {
+		tallies[i] = make([]float64, teams)
+	}
+	return tallies
+}
+
+func tallyPositions(tallies [][]float64, rankings []Ranking, teamsToIndices map[string]int) {
+	// Rankings which share a rank span the positions from that rank onwards.
+	for start := 0; start < len(rankings); {
+		end := start + 1
+		for end < len(rankings) && rankings[end].Rank == rankings[start].Rank {
+			end++
+		}
+		share := 1 / float64(end-start)
+		for _, ranking := range rankings[start:end] {
+			for position := start; position < end; position++ {
+				tallies[teamsToIndices[ranking.Team]][position] += share
+			}
+		}
+		start = end
+	}
+}
+
+// Each season's seed is mixed from the seed and the season, so that nearby
+// seeds, e.g. 1 and 2, share none of their seasons. Mixes as splitmix64 does.
+func seasonSeed(seed int64, season int) int64 {
+	z := uint64(seed) + uint64(season+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64(z ^ (z >> 31))
+}
+
+// Uses Knuth's method, which is fine for the small rates seen in sports.
+func samplePoisson(rng *rand.Rand, rate float64) int {
+	if rate <= 0 {
+		return 0
+	}
+	limit := math.Exp(-rate)
+	k, p := 0, rng.Float64()
+	for p > limit {
+		k++
+		p *= rng.Float64()
+	}
+	return k
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
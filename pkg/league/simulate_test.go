@@ -0,0 +1,126 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func simulationPlayedFixture() []league.GameResult {
+	return []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 3},
+		{TeamA: "Tarantulas", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 0},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 1},
+		{TeamA: "Tarantulas", ScoreA: 3, TeamB: "Snakes", ScoreB: 1},
+		{TeamA: "Lions", ScoreA: 4, TeamB: "Grouches", ScoreB: 0},
+	}
+}
+
+func simulationFixturesFixture() []league.Fixture {
+	return []league.Fixture{
+		{TeamA: "Lions", TeamB: "Tarantulas"},
+		{TeamA: "Snakes", TeamB: "FC Awesome"},
+		{TeamA: "Grouches", TeamB: "Tarantulas"},
+		{TeamA: "Grouches", TeamB: "Snakes"},
+	}
+}
+
+func TestSimulateSeasons_ShouldGiveProbabilityDistributions(t *testing.T) {
+	// Setup fixture
+	optsFixture := league.DefaultSimulationOptions()
+	optsFixture.Seasons = 1000
+
+	// Exercise SUT
+	actual := league.SimulateSeasons(simulationPlayedFixture(), simulationFixturesFixture(), optsFixture)
+
+	// Verify results
+	assert.Len(t, actual, 5)
+	positionTotals := make([]float64, 5)
+	for _, probabilities := range actual {
+		assert.Len(t, probabilities.Positions, 5)
+		teamTotal := 0.0
+		for i, probability := range probabilities.Positions {
+			teamTotal += probability
+			positionTotals[i] += probability
+		}
+		assert.InDelta(t, 1, teamTotal, 1e-9, probabilities.Team)
+	}
+	for i, total := range positionTotals {
+		assert.InDelta(t, 1, total, 1e-9, "position %d", i+1)
+	}
+	for i := 1; i < len(actual); i++ {
+		assert.LessOrEqual(t, actual[i-1].ExpectedPosition(), actual[i].ExpectedPosition())
+	}
+	assert.Equal(t, "Tarantulas", actual[0].Team)
+}
+
+func TestSimulateSeasons_GivenSameSeed_ShouldBeReproducible(t *testing.T) {
+	// Setup fixture
+	optsFixture := league.DefaultSimulationOptions()
+	optsFixture.Seasons = 600
+	optsFixture.Seed = 42
+	optsFixture.Workers = 1
+	otherWorkersFixture := optsFixture
+	otherWorkersFixture.Workers = 4
+	otherSeedFixture := optsFixture
+	otherSeedFixture.Seed = 43
+
+	// Exercise SUT
+	actual := league.SimulateSeasons(simulationPlayedFixture(), simulationFixturesFixture(), optsFixture)
+	otherWorkers := league.SimulateSeasons(simulationPlayedFixture(), simulationFixturesFixture(), otherWorkersFixture)
+	otherSeed := league.SimulateSeasons(simulationPlayedFixture(), simulationFixturesFixture(), otherSeedFixture)
+
+	// Verify results
+	assert.Equal(t, actual, otherWorkers)
+	assert.NotEqual(t, actual, otherSeed)
+}
+
+func TestSimulateSeasons_GivenNoFixtures_ShouldUseFinalTable(t *testing.T) {
+	// Setup fixture
+	optsFixture := league.DefaultSimulationOptions()
+	optsFixture.Seasons = 10
+
+	// Setup expectations
+	// -> FC Awesome and Snakes are level, so share 3rd and 4th.
+	expected := []league.PositionProbabilities{
+		{Team: "Tarantulas", Positions: []float64{1, 0, 0, 0, 0}},
+		{Team: "Lions", Positions: []float64{0, 1, 0, 0, 0}},
+		{Team: "FC Awesome", Positions: []float64{0, 0, 0.5, 0.5, 0}},
+		{Team: "Snakes", Positions: []float64{0, 0, 0.5, 0.5, 0}},
+		{Team: "Grouches", Positions: []float64{0, 0, 0, 0, 1}},
+	}
+
+	// Exercise SUT
+	actual := league.SimulateSeasons(simulationPlayedFixture(), nil, optsFixture)
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+}
+
+func TestSimulateSeasons_GivenNewTeamInFixtures_ShouldInclude(t *testing.T) {
+	// Setup fixture
+	optsFixture := league.DefaultSimulationOptions()
+	optsFixture.Seasons = 100
+	fixturesFixture := []league.Fixture{{TeamA: "Bears", TeamB: "Grouches"}}
+
+	// Exercise SUT
+	actual := league.SimulateSeasons(simulationPlayedFixture(), fixturesFixture, optsFixture)
+
+	// Verify results
+	assert.Len(t, actual, 6)
+	assert.Equal(t, "Tarantulas", actual[0].Team)
+	assert.Equal(t, 1.0, actual[0].Positions[0])
+}
+
+func TestSimulateSeasons_GivenNoSeasons_ShouldReturnNil(t *testing.T) {
+	// Setup fixture
+	optsFixture := league.DefaultSimulationOptions()
+	optsFixture.Seasons = 0
+
+	// Exercise SUT
+	actual := league.SimulateSeasons(simulationPlayedFixture(), nil, optsFixture)
+
+	// Verify results
+	assert.Nil(t, actual)
+}
@@ -0,0 +1,32 @@
+package league
+
+// --- TeamGames related ---
+
+// Reversed is the same game with the teams swapped, so team B is team A.
+func (gr GameResult) Reversed() GameResult {
+	reversed := gr
+	reversed.TeamA, reversed.TeamB = gr.TeamB, gr.TeamA
+	reversed.ScoreA, reversed.ScoreB = gr.ScoreB, gr.ScoreA
+	switch gr.Status {
+	case StatusAwardedA:
+		reversed.Status = StatusAwardedB
+	case StatusAwardedB:
+		reversed.Status = StatusAwardedA
+	}
+	return reversed
+}
+
+// Determine the games a team was in, including abandoned games, in the order
+// given. Each is reversed if need be so that the team is team A.
+func TeamGames(gameResults []GameResult, team string) []GameResult {
+	var games []GameResult
+	for _, gameResult := range gameResults {
+		switch team {
+		case gameResult.TeamA:
+			games = append(games, gameResult)
+		case gameResult.TeamB:
+			games = append(games, gameResult.Reversed())
+		}
+	}
+	return games
+}
@@ -0,0 +1,53 @@
+package league_test
+
+import (
+	"testing"
+
+	"github.com/liampulles/ranking-cli/pkg/league"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGameResult_Reversed(t *testing.T) {
+	// Setup fixture
+	gameResultFixture := league.GameResult{
+		TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 2,
+		Status: league.StatusAwardedA, Decision: league.DecisionOvertime, Round: 2,
+	}
+
+	// Setup expectations
+	expected := league.GameResult{
+		TeamA: "Snakes", ScoreA: 2, TeamB: "Lions", ScoreB: 3,
+		Status: league.StatusAwardedB, Decision: league.DecisionOvertime, Round: 2,
+	}
+
+	// Exercise SUT
+	actual := gameResultFixture.Reversed()
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, gameResultFixture, actual.Reversed())
+}
+
+func TestTeamGames(t *testing.T) {
+	// Setup fixture
+	gameResultsFixture := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 3},
+		{TeamA: "Tarantulas", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 0},
+		{TeamA: "Grouches", TeamB: "Lions", Status: league.StatusAwardedA},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 1, Status: league.StatusAbandoned},
+	}
+
+	// Setup expectations
+	expected := []league.GameResult{
+		{TeamA: "Lions", ScoreA: 3, TeamB: "Snakes", ScoreB: 3},
+		{TeamA: "Lions", TeamB: "Grouches", Status: league.StatusAwardedB},
+		{TeamA: "Lions", ScoreA: 1, TeamB: "FC Awesome", ScoreB: 1, Status: league.StatusAbandoned},
+	}
+
+	// Exercise SUT
+	actual := league.TeamGames(gameResultsFixture, "Lions")
+
+	// Verify results
+	assert.Equal(t, expected, actual)
+	assert.Nil(t, league.TeamGames(gameResultsFixture, "Bears"))
+}